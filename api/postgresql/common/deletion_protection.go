@@ -0,0 +1,15 @@
+package common
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// DeletionProtectionAnnotation can be set to "true" on a resource to protect it against deletion.
+const DeletionProtectionAnnotation = "postgresql.easymile.com/deletion-protection"
+
+// DeletionProtectionMessage is the message reported when a protected resource deletion is refused.
+const DeletionProtectionMessage = "deletion protection is enabled, set spec.deletionProtection to false and remove " +
+	DeletionProtectionAnnotation + " annotation to allow deletion"
+
+// IsDeletionProtected will return true if deletion protection is enabled with the spec field or the annotation.
+func IsDeletionProtected(obj metav1.Object, specField bool) bool {
+	return specField || obj.GetAnnotations()[DeletionProtectionAnnotation] == "true"
+}
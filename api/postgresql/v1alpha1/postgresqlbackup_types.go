@@ -0,0 +1,184 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+type BackupFormat string
+
+const CustomBackupFormat BackupFormat = "custom"
+const PlainBackupFormat BackupFormat = "plain"
+
+// PostgresqlBackupSpec defines the desired state of PostgresqlBackup.
+type PostgresqlBackupSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Postgresql Database
+	// +required
+	// +kubebuilder:validation:Required
+	Database *common.CRLink `json:"database"`
+	// Backup destination
+	// +required
+	// +kubebuilder:validation:Required
+	Destination *PostgresqlBackupDestination `json:"destination"`
+	// Dump format
+	// Default value will be "custom"
+	// +optional
+	// +kubebuilder:validation:Enum=custom;plain
+	Format BackupFormat `json:"format,omitempty"`
+	// Container image containing pg_dump.
+	// Note: pg_dump version must be greater or equal to the server one.
+	// Default value will be "postgres:16-alpine"
+	// +optional
+	PgDumpImage string `json:"pgDumpImage,omitempty"`
+	// Extra arguments given to pg_dump
+	// Note: Connection and output arguments aren't allowed.
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+	// Should delete the backup artifact on CR deletion ?
+	// +optional
+	DeleteArtifactOnDelete bool `json:"deleteArtifactOnDelete,omitempty"`
+}
+
+type PostgresqlBackupDestination struct {
+	// Persistent volume claim destination
+	// Note: This is mutually exclusive with "s3"
+	// +optional
+	PersistentVolumeClaim *PostgresqlBackupPVCDestination `json:"persistentVolumeClaim,omitempty"`
+	// S3 compatible destination
+	// Note: This is mutually exclusive with "persistentVolumeClaim"
+	// +optional
+	S3 *PostgresqlBackupS3Destination `json:"s3,omitempty"`
+}
+
+type PostgresqlBackupPVCDestination struct {
+	// Persistent volume claim name in the same namespace
+	// +required
+	// +kubebuilder:validation:Required
+	ClaimName string `json:"claimName"`
+	// Path (directory) in the volume
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+type PostgresqlBackupS3Destination struct {
+	// Bucket name
+	// +required
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+	// Path (key prefix) in the bucket
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Endpoint url for S3 compatible storages (MinIO, ...)
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Secret name in the same namespace containing
+	// "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY" keys
+	// +required
+	// +kubebuilder:validation:Required
+	CredentialsSecretName string `json:"credentialsSecretName"`
+	// Container image containing aws cli used for upload.
+	// Default value will be "amazon/aws-cli:2.15.0"
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+type BackupStatusPhase string
+
+const BackupNoPhase BackupStatusPhase = ""
+const BackupFailedPhase BackupStatusPhase = "Failed"
+const BackupRunningPhase BackupStatusPhase = "Running"
+const BackupSucceededPhase BackupStatusPhase = "Succeeded"
+
+// PostgresqlBackupStatus defines the observed state of PostgresqlBackup.
+type PostgresqlBackupStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase BackupStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Job name running the backup
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+	// Temporary role used by backup job
+	// +optional
+	Role string `json:"role,omitempty"`
+	// Artifact path relative to the destination root
+	// +optional
+	ArtifactPath string `json:"artifactPath,omitempty"`
+	// Artifact full location
+	// +optional
+	Location string `json:"location,omitempty"`
+	// Backup start time
+	// +optional
+	StartTime string `json:"startTime,omitempty"`
+	// Backup completion time
+	// +optional
+	CompletionTime string `json:"completionTime,omitempty"`
+	// Backup duration
+	// +optional
+	Duration string `json:"duration,omitempty"`
+	// Artifact size in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlbackups,scope=Namespaced,shortName=pgbackup;pgbkp
+//+kubebuilder:printcolumn:name="Location",type=string,description="Artifact location",JSONPath=".status.location"
+//+kubebuilder:printcolumn:name="Size",type=integer,description="Artifact size",JSONPath=".status.size"
+//+kubebuilder:printcolumn:name="Duration",type=string,description="Backup duration",JSONPath=".status.duration"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlBackup is the Schema for the postgresqlbackups API.
+type PostgresqlBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlBackupSpec   `json:"spec,omitempty"`
+	Status PostgresqlBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlBackupList contains a list of PostgresqlBackup.
+type PostgresqlBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlBackup{}, &PostgresqlBackupList{})
+}
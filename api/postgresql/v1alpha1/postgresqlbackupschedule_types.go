@@ -0,0 +1,120 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PostgresqlBackupScheduleSpec defines the desired state of PostgresqlBackupSchedule.
+type PostgresqlBackupScheduleSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Cron schedule (5 fields or @hourly, @daily, @weekly, @monthly, @yearly descriptors).
+	// Times are in UTC.
+	// +required
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+	// Backup specification used to create PostgresqlBackup objects
+	// +required
+	// +kubebuilder:validation:Required
+	BackupSpec *PostgresqlBackupSpec `json:"backupSpec"`
+	// Retention policy applied on finished backups created by this schedule
+	// +optional
+	Retention *PostgresqlBackupRetention `json:"retention,omitempty"`
+	// Suspend backup creation
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+type PostgresqlBackupRetention struct {
+	// Number of succeeded backups to keep (failed backups are counted separately)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	KeepLast *int `json:"keepLast,omitempty"`
+	// Maximum age of finished backups (Go duration format, example: "168h")
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
+type BackupScheduleStatusPhase string
+
+const BackupScheduleNoPhase BackupScheduleStatusPhase = ""
+const BackupScheduleFailedPhase BackupScheduleStatusPhase = "Failed"
+const BackupScheduleActivePhase BackupScheduleStatusPhase = "Active"
+const BackupScheduleSuspendedPhase BackupScheduleStatusPhase = "Suspended"
+
+// PostgresqlBackupScheduleStatus defines the observed state of PostgresqlBackupSchedule.
+type PostgresqlBackupScheduleStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase BackupScheduleStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Last schedule time
+	// +optional
+	LastScheduleTime string `json:"lastScheduleTime,omitempty"`
+	// Next schedule time
+	// +optional
+	NextScheduleTime string `json:"nextScheduleTime,omitempty"`
+	// Last created backup name
+	// +optional
+	LastBackupName string `json:"lastBackupName,omitempty"`
+	// Last successful backup name
+	// +optional
+	LastSuccessfulBackupName string `json:"lastSuccessfulBackupName,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlbackupschedules,scope=Namespaced,shortName=pgbackupschedule;pgbkps
+//+kubebuilder:printcolumn:name="Schedule",type=string,description="Schedule",JSONPath=".spec.schedule"
+//+kubebuilder:printcolumn:name="Suspend",type=boolean,description="Suspended",JSONPath=".spec.suspend"
+//+kubebuilder:printcolumn:name="Last successful backup",type=string,description="Last successful backup",JSONPath=".status.lastSuccessfulBackupName"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlBackupSchedule is the Schema for the postgresqlbackupschedules API.
+type PostgresqlBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlBackupScheduleSpec   `json:"spec,omitempty"`
+	Status PostgresqlBackupScheduleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlBackupScheduleList contains a list of PostgresqlBackupSchedule.
+type PostgresqlBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlBackupSchedule{}, &PostgresqlBackupScheduleList{})
+}
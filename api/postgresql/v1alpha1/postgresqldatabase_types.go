@@ -43,6 +43,25 @@ type PostgresqlDatabaseSpec struct {
 	// Should drop database on Custom Resource deletion ?
 	// +optional
 	DropOnDelete bool `json:"dropOnDelete,omitempty"`
+	// Drop strategy used when sessions are still connected on database.
+	// "Fail" will fail deletion, "Wait" will wait for sessions to be closed until "dropWaitTimeout"
+	// and "Force" will revoke connect, terminate sessions and drop database.
+	// Default value is "Fail".
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Wait;Force
+	DropStrategy DatabaseDropStrategy `json:"dropStrategy,omitempty"`
+	// Maximum time to wait for sessions to be closed with "Wait" drop strategy (Go duration format).
+	// Default value is "5m".
+	// +optional
+	DropWaitTimeout string `json:"dropWaitTimeout,omitempty"`
+	// Soft delete database instead of dropping it immediately.
+	// This is only used when "dropOnDelete" is enabled.
+	// +optional
+	SoftDelete *DatabaseSoftDelete `json:"softDelete,omitempty"`
+	// Protect resource against deletion.
+	// Deletion will be blocked until this is disabled.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
 	// Wait for linked resource to be deleted
 	// +optional
 	WaitLinkedResourcesDeletion bool `json:"waitLinkedResourcesDeletion,omitempty"`
@@ -51,11 +70,40 @@ type PostgresqlDatabaseSpec struct {
 	Schemas DatabaseModulesList `json:"schemas,omitempty"`
 	// Extensions to enable
 	// +optional
-	Extensions DatabaseModulesList `json:"extensions,omitempty"`
+	Extensions DatabaseExtensionsList `json:"extensions,omitempty"`
 	// Postgresql Engine Configuration link
 	// +required
 	// +kubebuilder:validation:Required
 	EngineConfiguration *common.CRLink `json:"engineConfiguration"`
+	// Initialize database from a source.
+	// This is only used when database is created by the operator.
+	// +optional
+	InitFrom *DatabaseInitSource `json:"initFrom,omitempty"`
+}
+
+type DatabaseDropStrategy string
+
+const DatabaseFailDropStrategy DatabaseDropStrategy = "Fail"
+const DatabaseWaitDropStrategy DatabaseDropStrategy = "Wait"
+const DatabaseForceDropStrategy DatabaseDropStrategy = "Force"
+
+type DatabaseSoftDelete struct {
+	// Retention period before tombstone database is dropped (Go duration format, e.g. "168h").
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Retention string `json:"retention"`
+}
+
+type DatabaseInitSource struct {
+	// PostgresqlDatabase to clone. It must be on the same engine.
+	// Note: This is mutually exclusive with "backup"
+	// +optional
+	Database *common.CRLink `json:"database,omitempty"`
+	// Succeeded PostgresqlBackup to restore. It must be in the same namespace.
+	// Note: This is mutually exclusive with "database"
+	// +optional
+	Backup *common.CRLink `json:"backup,omitempty"`
 }
 
 type DatabaseModulesList struct {
@@ -71,11 +119,59 @@ type DatabaseModulesList struct {
 	DeleteWithCascade bool `json:"deleteWithCascade,omitempty"`
 }
 
+type DatabaseExtensionsList struct {
+	// Extensions list
+	// +optional
+	// +listType=set
+	List []string `json:"list,omitempty"`
+	// Should drop on delete ?
+	// +optional
+	DropOnOnDelete bool `json:"dropOnDelete,omitempty"`
+	// Should drop with cascade ?
+	// +optional
+	DeleteWithCascade bool `json:"deleteWithCascade,omitempty"`
+	// Per extension options.
+	// Extensions must also be declared in list.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Options []DatabaseExtensionOptions `json:"options,omitempty"`
+}
+
+type DatabaseExtensionOptions struct {
+	// Extension name
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Extension version.
+	// Extension will be updated when changed.
+	// Default version of the engine will be used if not set.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Schema where extension objects will be installed.
+	// Extension will be moved when changed.
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Install extensions this one depends on.
+	// +optional
+	Cascade bool `json:"cascade,omitempty"`
+}
+
 type DatabaseStatusPhase string
 
 const DatabaseNoPhase DatabaseStatusPhase = ""
 const DatabaseFailedPhase DatabaseStatusPhase = "Failed"
 const DatabaseCreatedPhase DatabaseStatusPhase = "Created"
+const DatabaseInitializingPhase DatabaseStatusPhase = "Initializing"
+const DatabaseDeletingPhase DatabaseStatusPhase = "Deleting"
+
+type DatabaseInitializationPhase string
+
+const DatabaseInitializationRunningPhase DatabaseInitializationPhase = "Running"
+const DatabaseInitializationFailedPhase DatabaseInitializationPhase = "Failed"
+const DatabaseInitializationCompletedPhase DatabaseInitializationPhase = "Completed"
+const DatabaseInitializationSkippedPhase DatabaseInitializationPhase = "Skipped"
 
 // PostgresqlDatabaseStatus defines the observed state of PostgresqlDatabase.
 type PostgresqlDatabaseStatus struct {
@@ -105,6 +201,71 @@ type PostgresqlDatabaseStatus struct {
 	// +optional
 	// +listType=set
 	Extensions []string `json:"extensions,omitempty"`
+	// Installed extensions versions
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ExtensionVersions []DatabaseExtensionVersionStatus `json:"extensionVersions,omitempty"`
+	// Database initialization status
+	// +optional
+	Initialization *DatabaseInitializationStatus `json:"initialization,omitempty"`
+	// Sessions blocking database drop
+	// +optional
+	BlockingSessions []DatabaseBlockingSession `json:"blockingSessions,omitempty"`
+}
+
+type DatabaseExtensionVersionStatus struct {
+	// Extension name
+	Name string `json:"name"`
+	// Installed version
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	// Schema containing extension objects
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Default version available on engine
+	// +optional
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+	// Versions available on engine
+	// +optional
+	AvailableVersions []string `json:"availableVersions,omitempty"`
+}
+
+type DatabaseBlockingSession struct {
+	// Backend process id
+	Pid int `json:"pid"`
+	// Session user name
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Session application name
+	// +optional
+	ApplicationName string `json:"applicationName,omitempty"`
+	// Session client address
+	// +optional
+	ClientAddr string `json:"clientAddr,omitempty"`
+}
+
+type DatabaseInitializationStatus struct {
+	// Initialization phase
+	Phase DatabaseInitializationPhase `json:"phase"`
+	// Initialization source kind (PostgresqlDatabase or PostgresqlBackup)
+	// +optional
+	SourceKind string `json:"sourceKind,omitempty"`
+	// Initialization source (namespace/name)
+	// +optional
+	Source string `json:"source,omitempty"`
+	// Source database owner role (used to repair ownership after a clone)
+	// +optional
+	SourceOwner string `json:"sourceOwner,omitempty"`
+	// Source database reader and writer roles (privileges are revoked after a clone)
+	// +optional
+	SourceRoles []string `json:"sourceRoles,omitempty"`
+	// Restore job name
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+	// Temporary role used by restore job
+	// +optional
+	Role string `json:"role,omitempty"`
 }
 
 // StatusPostgresRoles stores the different group roles already created for database
@@ -58,6 +58,9 @@ type PostgresqlEngineConfigurationSpec struct {
 	AllowGrantAdminOption bool `json:"allowGrantAdminOption,omitempty"`
 	// Wait for linked resource to be deleted
 	WaitLinkedResourcesDeletion bool `json:"waitLinkedResourcesDeletion,omitempty"`
+	// Protect resource against deletion.
+	// Deletion will be blocked until this is disabled.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
 	// User and password secret
 	// +required
 	// +kubebuilder:validation:Required
@@ -69,8 +72,22 @@ type PostgresqlEngineConfigurationSpec struct {
 	// Note: Operator won't check those values.
 	// +optional
 	UserConnections *UserConnections `json:"userConnections"`
+	// Default password policy for user roles linked to this engine
+	// This will be used when user role doesn't have any password policy.
+	// +optional
+	DefaultPasswordPolicy *PasswordPolicy `json:"defaultPasswordPolicy,omitempty"`
+	// Allowed dangerous user role attributes
+	// User roles linked to this engine will be able to enable only those dangerous attributes.
+	// +optional
+	AllowedUserRoleAttributes []DangerousUserRoleAttribute `json:"allowedUserRoleAttributes,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=CREATEDB;CREATEROLE
+type DangerousUserRoleAttribute string
+
+const CreateDBDangerousUserRoleAttribute DangerousUserRoleAttribute = "CREATEDB"
+const CreateRoleDangerousUserRoleAttribute DangerousUserRoleAttribute = "CREATEROLE"
+
 type UserConnections struct {
 	// Primary connection is referring to the primary node connection.
 	// +optional
@@ -124,6 +141,20 @@ type PostgresqlEngineConfigurationStatus struct {
 	// Resource Spec hash
 	// +optional
 	Hash string `json:"hash"`
+	// Soft deleted databases waiting to be dropped
+	// +optional
+	Tombstones []DatabaseTombstone `json:"tombstones,omitempty"`
+}
+
+type DatabaseTombstone struct {
+	// Tombstone database name
+	Name string `json:"name"`
+	// Original database name
+	Database string `json:"database"`
+	// Deletion time
+	DeletedAt string `json:"deletedAt"`
+	// Time after which tombstone will be dropped
+	DropAfter string `json:"dropAfter"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,120 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PostgresqlMigrationSpec defines the desired state of PostgresqlMigration.
+type PostgresqlMigrationSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Postgresql Database
+	// +required
+	// +kubebuilder:validation:Required
+	Database *common.CRLink `json:"database"`
+	// ConfigMap name containing SQL files in the same namespace.
+	// Note: This is mutually exclusive with "secretName"
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// Secret name containing SQL files in the same namespace.
+	// Note: This is mutually exclusive with "configMapName"
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// Tracking table schema
+	// Default value will be "public"
+	// +optional
+	TrackingTableSchema string `json:"trackingTableSchema,omitempty"`
+	// Tracking table name
+	// Default value will be "postgresql_operator_migrations"
+	// +optional
+	TrackingTableName string `json:"trackingTableName,omitempty"`
+}
+
+type MigrationStatusPhase string
+
+const MigrationNoPhase MigrationStatusPhase = ""
+const MigrationFailedPhase MigrationStatusPhase = "Failed"
+const MigrationAppliedPhase MigrationStatusPhase = "Applied"
+
+type PostgresqlMigrationAppliedVersion struct {
+	// Migration version
+	Version string `json:"version"`
+	// Migration content checksum (sha256)
+	Checksum string `json:"checksum"`
+	// Migration application date
+	// +optional
+	AppliedAt string `json:"appliedAt,omitempty"`
+}
+
+// PostgresqlMigrationStatus defines the observed state of PostgresqlMigration.
+type PostgresqlMigrationStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase MigrationStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Last applied migration version
+	// +optional
+	LastAppliedVersion string `json:"lastAppliedVersion,omitempty"`
+	// Applied migrations
+	// +optional
+	AppliedVersions []*PostgresqlMigrationAppliedVersion `json:"appliedVersions,omitempty"`
+	// Temporary role used to apply migrations that hasn't been dropped yet
+	// +optional
+	TemporaryRole string `json:"temporaryRole,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlmigrations,scope=Namespaced,shortName=pgmigration;pgmig
+//+kubebuilder:printcolumn:name="Last applied version",type=string,description="Last applied version",JSONPath=".status.lastAppliedVersion"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlMigration is the Schema for the postgresqlmigrations API.
+type PostgresqlMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlMigrationSpec   `json:"spec,omitempty"`
+	Status PostgresqlMigrationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlMigrationList contains a list of PostgresqlMigration.
+type PostgresqlMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlMigration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlMigration{}, &PostgresqlMigrationList{})
+}
@@ -48,6 +48,10 @@ type PostgresqlPublicationSpec struct {
 	// Should drop database on Custom Resource deletion ?
 	// +optional
 	DropOnDelete bool `json:"dropOnDelete,omitempty"`
+	// Protect resource against deletion.
+	// Deletion will be blocked until this is disabled.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
 	// Publication for all tables
 	// Note: This is mutually exclusive with "tablesInSchema" & "tables"
 	// +optional
@@ -59,9 +63,160 @@ type PostgresqlPublicationSpec struct {
 	// Publication for selected tables
 	// +optional
 	Tables []*PostgresqlPublicationTable `json:"tables,omitempty"`
+	// Publication for tables discovered from database catalog on each reconcile
+	// Note: This is mutually exclusive with "allTables" & "tablesInSchema" and can be combined with "tables"
+	// +optional
+	TableSelector *PostgresqlPublicationTableSelector `json:"tableSelector,omitempty"`
 	// Publication with parameters
 	// +optional
 	WithParameters *PostgresqlPublicationWith `json:"withParameters,omitempty"`
+	// Publication owner
+	// Default value will be the database owner role
+	// +optional
+	Owner *PostgresqlPublicationOwner `json:"owner,omitempty"`
+	// Replication slot monitoring configuration
+	// +optional
+	SlotMonitoring *PostgresqlPublicationSlotMonitoring `json:"slotMonitoring,omitempty"`
+	// Replication slot safeguard policy
+	// +optional
+	SlotSafeguard *PostgresqlPublicationSlotSafeguard `json:"slotSafeguard,omitempty"`
+	// Change Data Capture (e.g. Debezium) helpers
+	// +optional
+	CDC *PostgresqlPublicationCDC `json:"cdc,omitempty"`
+}
+
+// +kubebuilder:validation:Pattern=`^(DEFAULT|FULL|NOTHING|USING INDEX .+)$`
+type PublicationReplicaIdentity string
+
+const DefaultPublicationReplicaIdentity PublicationReplicaIdentity = "DEFAULT"
+const FullPublicationReplicaIdentity PublicationReplicaIdentity = "FULL"
+const NothingPublicationReplicaIdentity PublicationReplicaIdentity = "NOTHING"
+
+type PostgresqlPublicationCDC struct {
+	// Schema of signaling and heartbeat tables.
+	// Default value will be "public"
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Signaling table name.
+	// Table will be created and owned by database owner if set.
+	// +optional
+	SignalingTableName string `json:"signalingTableName,omitempty"`
+	// Heartbeat table name.
+	// Table will be created and owned by database owner if set.
+	// +optional
+	HeartbeatTableName string `json:"heartbeatTableName,omitempty"`
+	// Replica identity per table
+	// +optional
+	ReplicaIdentities []*PostgresqlPublicationCDCReplicaIdentity `json:"replicaIdentities,omitempty"`
+	// Dedicated replication user
+	// +optional
+	ReplicationUser *PostgresqlPublicationCDCReplicationUser `json:"replicationUser,omitempty"`
+}
+
+type PostgresqlPublicationCDCReplicaIdentity struct {
+	// Table name
+	// +required
+	// +kubebuilder:validation:Required
+	TableName string `json:"tableName"`
+	// Replica identity (DEFAULT, FULL, NOTHING or "USING INDEX <index name>")
+	// +required
+	// +kubebuilder:validation:Required
+	ReplicaIdentity PublicationReplicaIdentity `json:"replicaIdentity"`
+}
+
+type PostgresqlPublicationCDCReplicationUser struct {
+	// Role name.
+	// Default value will be the publication name with a "-cdc" suffix
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+	// Generated secret name.
+	// Secret will have the same format as PostgresqlUserRole generated secrets.
+	// +required
+	// +kubebuilder:validation:Required
+	GeneratedSecretName string `json:"generatedSecretName"`
+	// Extra connection URL Parameters
+	// +optional
+	ExtraConnectionURLParameters map[string]string `json:"extraConnectionUrlParameters,omitempty"`
+}
+
+type PublicationOwnerType string
+
+const DatabaseOwnerPublicationOwnerType PublicationOwnerType = "DatabaseOwner"
+const UserRolePublicationOwnerType PublicationOwnerType = "UserRole"
+const EngineConfigurationUserPublicationOwnerType PublicationOwnerType = "EngineConfigurationUser"
+
+type PostgresqlPublicationOwner struct {
+	// Owner type.
+	// DatabaseOwner will use the database owner role, UserRole the PostgresqlUserRole role
+	// and EngineConfigurationUser the PostgresqlEngineConfiguration user.
+	// Default value will be "DatabaseOwner"
+	// +optional
+	// +kubebuilder:validation:Enum=DatabaseOwner;UserRole;EngineConfigurationUser
+	Type PublicationOwnerType `json:"type,omitempty"`
+	// PostgresqlUserRole reference
+	// Must be set with UserRole type
+	// +optional
+	UserRole *common.CRLink `json:"userRole,omitempty"`
+}
+
+type TableSelectorPatternType string
+
+const GlobTableSelectorPatternType TableSelectorPatternType = "Glob"
+const RegexTableSelectorPatternType TableSelectorPatternType = "Regex"
+
+type PostgresqlPublicationTableSelector struct {
+	// Pattern type used for include and exclude lists.
+	// Default value will be "Glob"
+	// +optional
+	// +kubebuilder:validation:Enum=Glob;Regex
+	PatternType TableSelectorPatternType `json:"patternType,omitempty"`
+	// Patterns matched against "schema.table" names to include tables
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Patterns matched against "schema.table" names to exclude tables
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+	// Only select tables with a COMMENT containing this value
+	// +optional
+	CommentContains string `json:"commentContains,omitempty"`
+}
+
+type SlotSafeguardAction string
+
+const SlotSafeguardWarnAction SlotSafeguardAction = "Warn"
+const SlotSafeguardRecreateAction SlotSafeguardAction = "Recreate"
+const SlotSafeguardPauseAction SlotSafeguardAction = "Pause"
+
+type PostgresqlPublicationSlotSafeguard struct {
+	// Maximum retained WAL size (Kubernetes quantity format, e.g. "50Gi").
+	// +optional
+	MaxRetainedWAL string `json:"maxRetainedWAL,omitempty"`
+	// Maximum inactivity duration (Go duration format, e.g. "24h").
+	// +optional
+	MaxInactiveDuration string `json:"maxInactiveDuration,omitempty"`
+	// Action to take when a maximum is exceeded.
+	// Warn will only emit an event, Recreate will drop and recreate replication slot
+	// and Pause will mark publication as Failed.
+	// Default value will be "Warn"
+	// +optional
+	// +kubebuilder:validation:Enum=Warn;Recreate;Pause
+	Action SlotSafeguardAction `json:"action,omitempty"`
+}
+
+type PostgresqlPublicationSlotMonitoring struct {
+	// Interval between replication slot checks (Go duration format).
+	// Default value will be "1m"
+	// +optional
+	CheckInterval string `json:"checkInterval,omitempty"`
+	// Retained WAL size above which a Warning event is emitted (Kubernetes quantity format, e.g. "5Gi").
+	// +optional
+	RetainedWALWarningThreshold string `json:"retainedWALWarningThreshold,omitempty"`
+	// Confirmed flush lag size above which a Warning event is emitted (Kubernetes quantity format, e.g. "1Gi").
+	// +optional
+	ConfirmedFlushLagWarningThreshold string `json:"confirmedFlushLagWarningThreshold,omitempty"`
+	// Inactivity duration above which a Warning event is emitted (Go duration format, e.g. "1h").
+	// +optional
+	InactiveWarningThreshold string `json:"inactiveWarningThreshold,omitempty"`
 }
 
 type PostgresqlPublicationTable struct {
@@ -71,6 +226,9 @@ type PostgresqlPublicationTable struct {
 	Columns *[]string `json:"columns,omitempty"`
 	// Additional WHERE for table
 	AdditionalWhere *string `json:"additionalWhere,omitempty"`
+	// Replica identity (DEFAULT, FULL, NOTHING or "USING INDEX <index name>")
+	// +optional
+	ReplicaIdentity PublicationReplicaIdentity `json:"replicaIdentity,omitempty"`
 }
 
 type PostgresqlPublicationWith struct {
@@ -113,9 +271,70 @@ type PostgresqlPublicationStatus struct {
 	// Marker for save
 	// +optional
 	AllTables *bool `json:"allTables,omitempty"`
+	// Publication owner role
+	// +optional
+	Owner string `json:"owner,omitempty"`
 	// Resource Spec hash
 	// +optional
 	Hash string `json:"hash,omitempty"`
+	// Replication slot health
+	// +optional
+	ReplicationSlot *ReplicationSlotHealthStatus `json:"replicationSlot,omitempty"`
+	// Replication slot safeguard status
+	// +optional
+	SlotSafeguard *PublicationSlotSafeguardStatus `json:"slotSafeguard,omitempty"`
+	// Created CDC replication user role name
+	// +optional
+	CDCReplicationUserRoleName string `json:"cdcReplicationUserRoleName,omitempty"`
+	// Tables discovered by table selector ("schema.table" format)
+	// +optional
+	DiscoveredTables []string `json:"discoveredTables,omitempty"`
+	// Published tables without any usable replica identity for update and delete replication
+	// +optional
+	TablesWithoutReplicaIdentity []string `json:"tablesWithoutReplicaIdentity,omitempty"`
+}
+
+type PublicationSlotSafeguardStatus struct {
+	// Is safeguard currently triggered ?
+	Triggered bool `json:"triggered"`
+	// Last actions taken by safeguard (most recent last)
+	// +optional
+	Actions []PublicationSlotSafeguardActionStatus `json:"actions,omitempty"`
+}
+
+type PublicationSlotSafeguardActionStatus struct {
+	// Action taken
+	Action SlotSafeguardAction `json:"action"`
+	// Reason of the action
+	Reason string `json:"reason"`
+	// Action time
+	Time string `json:"time"`
+}
+
+type ReplicationSlotHealthStatus struct {
+	// Is a consumer connected to the replication slot ?
+	Active bool `json:"active"`
+	// Oldest WAL position still required by the replication slot
+	// +optional
+	RestartLSN string `json:"restartLSN,omitempty"`
+	// WAL position confirmed by the consumer
+	// +optional
+	ConfirmedFlushLSN string `json:"confirmedFlushLSN,omitempty"`
+	// WAL size retained by the replication slot
+	// +optional
+	RetainedWALBytes int64 `json:"retainedWALBytes"`
+	// WAL size not yet confirmed by the consumer
+	// +optional
+	ConfirmedFlushLagBytes int64 `json:"confirmedFlushLagBytes"`
+	// WAL files availability (reserved, extended, unreserved or lost). Empty before PostgreSQL 13.
+	// +optional
+	WALStatus string `json:"walStatus,omitempty"`
+	// First check time where replication slot was detected as inactive
+	// +optional
+	InactiveSince string `json:"inactiveSince,omitempty"`
+	// Last check time
+	// +optional
+	LastCheckTime string `json:"lastCheckTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -124,6 +343,7 @@ type PostgresqlPublicationStatus struct {
 //+kubebuilder:printcolumn:name="Publication",type=string,description="Publication",JSONPath=".status.name"
 //+kubebuilder:printcolumn:name="Replication slot name",type=string,description="Status phase",JSONPath=".status.replicationSlotName"
 //+kubebuilder:printcolumn:name="Replication slot plugin",type=string,description="Status phase",JSONPath=".status.replicationSlotPlugin"
+//+kubebuilder:printcolumn:name="Replication slot active",type=boolean,description="Replication slot active",JSONPath=".status.replicationSlot.active"
 //+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
 
 // PostgresqlPublication is the Schema for the postgresqlpublications API.
@@ -0,0 +1,62 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var pgpublicationlog = logf.Log.WithName("postgresqlpublication-resource")
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks.
+func (r *PostgresqlPublication) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-postgresql-easymile-com-v1alpha1-postgresqlpublication,mutating=false,failurePolicy=fail,sideEffects=None,groups=postgresql.easymile.com,resources=postgresqlpublications,verbs=delete,versions=v1alpha1,name=vpostgresqlpublication.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &PostgresqlPublication{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (*PostgresqlPublication) ValidateCreate() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (*PostgresqlPublication) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *PostgresqlPublication) ValidateDelete() (admission.Warnings, error) {
+	pgpublicationlog.Info("validate delete", "name", r.Name, "namespace", r.Namespace)
+
+	// Check deletion protection
+	if common.IsDeletionProtected(r, r.Spec.DeletionProtection) {
+		return nil, errDeletionProtected
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+type ReplicationSlotType string
+
+const LogicalReplicationSlotType ReplicationSlotType = "Logical"
+const PhysicalReplicationSlotType ReplicationSlotType = "Physical"
+
+// PostgresqlReplicationSlotSpec defines the desired state of PostgresqlReplicationSlot.
+type PostgresqlReplicationSlotSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Postgresql Database.
+	// Required for logical replication slots.
+	// Note: This is mutually exclusive with "engineConfiguration"
+	// +optional
+	Database *common.CRLink `json:"database,omitempty"`
+	// Postgresql Engine Configuration.
+	// Only for physical replication slots.
+	// Note: This is mutually exclusive with "database"
+	// +optional
+	EngineConfiguration *common.CRLink `json:"engineConfiguration,omitempty"`
+	// Postgresql replication slot name
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Replication slot type
+	// Default value will be "Logical"
+	// +optional
+	// +kubebuilder:validation:Enum=Logical;Physical
+	Type ReplicationSlotType `json:"type,omitempty"`
+	// Output plugin (logical only)
+	// Default value will be "pgoutput"
+	// +optional
+	Plugin string `json:"plugin,omitempty"`
+	// Enable decoding of prepared transactions (logical only, PostgreSQL 14+)
+	// +optional
+	TwoPhase bool `json:"twoPhase,omitempty"`
+	// Synchronize replication slot to standbys (logical only, PostgreSQL 17+)
+	// +optional
+	Failover bool `json:"failover,omitempty"`
+	// Reserve WAL immediately instead of waiting for the first consumer connection (physical only)
+	// +optional
+	ImmediatelyReserve bool `json:"immediatelyReserve,omitempty"`
+	// Should drop replication slot on Custom Resource deletion ?
+	// +optional
+	DropOnDelete bool `json:"dropOnDelete,omitempty"`
+	// Interval between replication slot checks (Go duration format).
+	// Default value will be "1m"
+	// +optional
+	CheckInterval string `json:"checkInterval,omitempty"`
+}
+
+type ReplicationSlotStatusPhase string
+
+const ReplicationSlotNoPhase ReplicationSlotStatusPhase = ""
+const ReplicationSlotFailedPhase ReplicationSlotStatusPhase = "Failed"
+const ReplicationSlotCreatedPhase ReplicationSlotStatusPhase = "Created"
+
+// PostgresqlReplicationSlotStatus defines the observed state of PostgresqlReplicationSlot.
+type PostgresqlReplicationSlotStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase ReplicationSlotStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Created replication slot name
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Created replication slot type
+	// +optional
+	Type ReplicationSlotType `json:"type,omitempty"`
+	// Created replication slot plugin
+	// +optional
+	Plugin string `json:"plugin,omitempty"`
+	// Created replication slot database
+	// +optional
+	Database string `json:"database,omitempty"`
+	// Replication slot health
+	// +optional
+	ReplicationSlot *ReplicationSlotHealthStatus `json:"replicationSlot,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlreplicationslots,scope=Namespaced,shortName=pgreplicationslot;pgslot
+//+kubebuilder:printcolumn:name="Replication slot name",type=string,description="Replication slot name",JSONPath=".status.name"
+//+kubebuilder:printcolumn:name="Type",type=string,description="Replication slot type",JSONPath=".status.type"
+//+kubebuilder:printcolumn:name="Active",type=boolean,description="Replication slot active",JSONPath=".status.replicationSlot.active"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlReplicationSlot is the Schema for the postgresqlreplicationslots API.
+type PostgresqlReplicationSlot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlReplicationSlotSpec   `json:"spec,omitempty"`
+	Status PostgresqlReplicationSlotStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlReplicationSlotList contains a list of PostgresqlReplicationSlot.
+type PostgresqlReplicationSlotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlReplicationSlot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlReplicationSlot{}, &PostgresqlReplicationSlotList{})
+}
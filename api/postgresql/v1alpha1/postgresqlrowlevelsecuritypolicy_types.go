@@ -0,0 +1,154 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PostgresqlRowLevelSecurityPolicySpec defines the desired state of PostgresqlRowLevelSecurityPolicy.
+type PostgresqlRowLevelSecurityPolicySpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Postgresql Database
+	// +required
+	// +kubebuilder:validation:Required
+	Database *common.CRLink `json:"database"`
+	// Postgresql policy name
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Table schema
+	// Default value will be "public"
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Table name
+	// +required
+	// +kubebuilder:validation:Required
+	Table string `json:"table"`
+	// Command on which policy is applied
+	// +optional
+	// +kubebuilder:default=ALL
+	// +kubebuilder:validation:Enum=ALL;SELECT;INSERT;UPDATE;DELETE
+	Command PolicyCommand `json:"command,omitempty"`
+	// Should the policy be restrictive instead of permissive ?
+	// +optional
+	Restrictive bool `json:"restrictive,omitempty"`
+	// Roles on which policy is applied
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+	// Database group roles on which policy is applied
+	// +optional
+	DatabaseRoles []PolicyDatabaseRole `json:"databaseRoles,omitempty"`
+	// Using expression
+	// +optional
+	Using *string `json:"using,omitempty"`
+	// With check expression
+	// +optional
+	WithCheck *string `json:"withCheck,omitempty"`
+	// Should force row level security on table ?
+	// This will apply policies to table owner.
+	// +optional
+	ForceRowLevelSecurity bool `json:"forceRowLevelSecurity,omitempty"`
+}
+
+type PolicyCommand string
+
+const AllPolicyCommand PolicyCommand = "ALL"
+const SelectPolicyCommand PolicyCommand = "SELECT"
+const InsertPolicyCommand PolicyCommand = "INSERT"
+const UpdatePolicyCommand PolicyCommand = "UPDATE"
+const DeletePolicyCommand PolicyCommand = "DELETE"
+
+type PolicyDatabaseRole string
+
+const ReaderPolicyDatabaseRole PolicyDatabaseRole = "READER"
+const WriterPolicyDatabaseRole PolicyDatabaseRole = "WRITER"
+
+type RowLevelSecurityPolicyStatusPhase string
+
+const RowLevelSecurityPolicyNoPhase RowLevelSecurityPolicyStatusPhase = ""
+const RowLevelSecurityPolicyFailedPhase RowLevelSecurityPolicyStatusPhase = "Failed"
+const RowLevelSecurityPolicyCreatedPhase RowLevelSecurityPolicyStatusPhase = "Created"
+
+// PostgresqlRowLevelSecurityPolicyStatus defines the observed state of PostgresqlRowLevelSecurityPolicy.
+type PostgresqlRowLevelSecurityPolicyStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase RowLevelSecurityPolicyStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Created policy name
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Created policy table schema
+	// +optional
+	Schema string `json:"schema,omitempty"`
+	// Created policy table
+	// +optional
+	Table string `json:"table,omitempty"`
+	// Using expression as stored in database
+	// +optional
+	Using *string `json:"using,omitempty"`
+	// With check expression as stored in database
+	// +optional
+	WithCheck *string `json:"withCheck,omitempty"`
+	// Resource Spec hash
+	// +optional
+	Hash string `json:"hash,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlrowlevelsecuritypolicies,scope=Namespaced,shortName=pgrlspolicy;pgrls
+//+kubebuilder:printcolumn:name="Policy",type=string,description="Policy",JSONPath=".status.name"
+//+kubebuilder:printcolumn:name="Schema",type=string,description="Table schema",JSONPath=".status.schema"
+//+kubebuilder:printcolumn:name="Table",type=string,description="Table",JSONPath=".status.table"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlRowLevelSecurityPolicy is the Schema for the postgresqlrowlevelsecuritypolicies API.
+type PostgresqlRowLevelSecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlRowLevelSecurityPolicySpec   `json:"spec,omitempty"`
+	Status PostgresqlRowLevelSecurityPolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlRowLevelSecurityPolicyList contains a list of PostgresqlRowLevelSecurityPolicy.
+type PostgresqlRowLevelSecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlRowLevelSecurityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlRowLevelSecurityPolicy{}, &PostgresqlRowLevelSecurityPolicyList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PostgresqlSequenceSyncSpec defines the desired state of PostgresqlSequenceSync.
+type PostgresqlSequenceSyncSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Postgresql Publication used as source.
+	// Sequences owned by published table columns will be synchronized.
+	// +required
+	// +kubebuilder:validation:Required
+	Publication *common.CRLink `json:"publication"`
+	// Postgresql Database used as target
+	// +required
+	// +kubebuilder:validation:Required
+	TargetDatabase *common.CRLink `json:"targetDatabase"`
+	// Safety offset added to source sequence values
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Offset int64 `json:"offset,omitempty"`
+}
+
+type SequenceSyncStatusPhase string
+
+const SequenceSyncNoPhase SequenceSyncStatusPhase = ""
+const SequenceSyncFailedPhase SequenceSyncStatusPhase = "Failed"
+const SequenceSyncSucceededPhase SequenceSyncStatusPhase = "Succeeded"
+
+// PostgresqlSequenceSyncStatus defines the observed state of PostgresqlSequenceSync.
+type PostgresqlSequenceSyncStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Current phase of the operator
+	Phase SequenceSyncStatusPhase `json:"phase"`
+	// Human-readable message indicating details about current operator phase or error.
+	// +optional
+	Message string `json:"message"`
+	// True if all resources are in a ready state and all work is done.
+	// +optional
+	Ready bool `json:"ready"`
+	// Synchronized sequences
+	// +optional
+	Sequences []*SequenceSyncResult `json:"sequences,omitempty"`
+	// Sequences ignored because they have never been used on source
+	// +optional
+	SkippedSequences []string `json:"skippedSequences,omitempty"`
+	// Synchronization time
+	// +optional
+	SyncTime string `json:"syncTime,omitempty"`
+}
+
+type SequenceSyncResult struct {
+	// Sequence name ("schema.sequence" format)
+	Name string `json:"name"`
+	// Last value read on source
+	SourceValue int64 `json:"sourceValue"`
+	// Value set on target
+	TargetValue int64 `json:"targetValue"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=postgresqlsequencesyncs,scope=Namespaced,shortName=pgseqsync
+//+kubebuilder:printcolumn:name="Sync time",type=string,description="Synchronization time",JSONPath=".status.syncTime"
+//+kubebuilder:printcolumn:name="Phase",type=string,description="Status phase",JSONPath=".status.phase"
+
+// PostgresqlSequenceSync is the Schema for the postgresqlsequencesyncs API.
+type PostgresqlSequenceSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresqlSequenceSyncSpec   `json:"spec,omitempty"`
+	Status PostgresqlSequenceSyncStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresqlSequenceSyncList contains a list of PostgresqlSequenceSync.
+type PostgresqlSequenceSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresqlSequenceSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresqlSequenceSync{}, &PostgresqlSequenceSyncList{})
+}
@@ -29,6 +29,7 @@ type PrivilegesSpecEnum string
 const OwnerPrivilege PrivilegesSpecEnum = "OWNER"
 const ReaderPrivilege PrivilegesSpecEnum = "READER"
 const WriterPrivilege PrivilegesSpecEnum = "WRITER"
+const CustomPrivilege PrivilegesSpecEnum = "CUSTOM"
 
 type ConnectionTypesSpecEnum string
 
@@ -45,7 +46,7 @@ type PostgresqlUserRolePrivilege struct {
 	// User privileges
 	// +required
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=OWNER;WRITER;READER
+	// +kubebuilder:validation:Enum=OWNER;WRITER;READER;CUSTOM
 	Privilege PrivilegesSpecEnum `json:"privilege"`
 	// Postgresql Database
 	// +required
@@ -58,6 +59,46 @@ type PostgresqlUserRolePrivilege struct {
 	GeneratedSecretName string `json:"generatedSecretName"`
 	// Extra connection URL Parameters
 	ExtraConnectionURLParameters map[string]string `json:"extraConnectionUrlParameters,omitempty"`
+	// Expiration time of this privilege (RFC3339 format)
+	// After this time, the privilege is revoked and the generated secret is deleted.
+	// Note: This can be used only with READER and WRITER privileges.
+	// +optional
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	// Table grants
+	// Fine-grained grants on tables and columns given directly to the user role.
+	// Note: This can be used only with CUSTOM privilege because other privileges switch to a group role on login.
+	// +optional
+	TableGrants []*PostgresqlUserRoleTableGrant `json:"tableGrants,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=SELECT;INSERT;UPDATE;DELETE
+type TableGrantPrivilege string
+
+const SelectTableGrantPrivilege TableGrantPrivilege = "SELECT"
+const InsertTableGrantPrivilege TableGrantPrivilege = "INSERT"
+const UpdateTableGrantPrivilege TableGrantPrivilege = "UPDATE"
+const DeleteTableGrantPrivilege TableGrantPrivilege = "DELETE"
+
+type PostgresqlUserRoleTableGrant struct {
+	// Schema name
+	// +optional
+	// +kubebuilder:default=public
+	Schema string `json:"schema,omitempty"`
+	// Table name
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Table string `json:"table"`
+	// Privileges granted on table or columns
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Privileges []TableGrantPrivilege `json:"privileges"`
+	// Columns list
+	// When set, privileges are granted only on those columns.
+	// Note: DELETE privilege cannot be granted on columns.
+	// +optional
+	Columns []string `json:"columns,omitempty"`
 }
 
 type PostgresqlUserRoleAttributes struct {
@@ -71,6 +112,53 @@ type PostgresqlUserRoleAttributes struct {
 	// Note: This can be either -1, a number or null (to ignore this parameter)
 	// Note: Increase your number by one because operator is using the created user to perform some operations.
 	ConnectionLimit *int `json:"connectionLimit,omitempty"`
+	// CREATEDB attribute
+	// Note: This can be either true, false or null (to ignore this parameter)
+	// Note: This must be allowed in engine configuration allowed user role attributes.
+	CreateDB *bool `json:"createDB,omitempty"` //nolint:tagliatelle
+	// CREATEROLE attribute
+	// Note: This can be either true, false or null (to ignore this parameter)
+	// Note: This must be allowed in engine configuration allowed user role attributes.
+	CreateRole *bool `json:"createRole,omitempty"`
+	// INHERIT attribute
+	// Note: This can be either true, false or null (to ignore this parameter)
+	Inherit *bool `json:"inherit,omitempty"`
+	// LOGIN attribute
+	// Note: This can be either true, false or null (to ignore this parameter)
+	// Note: Set it to false to temporary suspend the user login (NOLOGIN).
+	Login *bool `json:"login,omitempty"`
+	// VALID UNTIL attribute margin
+	// When set, the role password will expire at last password change time + user password rotation duration + this margin.
+	// The operator pushes it forward on every password rotation.
+	// Note: This can be used only in MANAGED mode with a user password rotation duration.
+	// Note: The margin must be greater than the resync period of the operator to let it rotate the password before expiration.
+	ValidUntilMargin string `json:"validUntilMargin,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=LOWERCASE;UPPERCASE;DIGITS;SPECIAL
+type PasswordCharacterClass string
+
+const LowercasePasswordCharacterClass PasswordCharacterClass = "LOWERCASE"
+const UppercasePasswordCharacterClass PasswordCharacterClass = "UPPERCASE"
+const DigitsPasswordCharacterClass PasswordCharacterClass = "DIGITS"
+const SpecialPasswordCharacterClass PasswordCharacterClass = "SPECIAL"
+
+type PasswordPolicy struct {
+	// Password length
+	// Note: In PROVIDED mode, this is the minimum length accepted.
+	// +optional
+	// +kubebuilder:default=15
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=100
+	Length int `json:"length,omitempty"`
+	// Character classes that must be used in password
+	// Note: At least one character of each class will be used and only those classes are allowed.
+	// +optional
+	// +kubebuilder:default={LOWERCASE,UPPERCASE,DIGITS}
+	CharacterClasses []PasswordCharacterClass `json:"characterClasses,omitempty"`
+	// Characters that mustn't be used in password
+	// +optional
+	ExcludedCharacters string `json:"excludedCharacters,omitempty"`
 }
 
 type ModeEnum string
@@ -99,6 +187,12 @@ type PostgresqlUserRoleSpec struct {
 	// User password rotation duration
 	// +optional
 	UserPasswordRotationDuration string `json:"userPasswordRotationDuration,omitempty"`
+	// Old roles termination grace period.
+	// After this duration from rotation, active sessions still opened with an old role are terminated
+	// and the old role is dropped.
+	// Note: If not set, old roles are kept until all their sessions are closed.
+	// +optional
+	OldRolesTerminationGracePeriod string `json:"oldRolesTerminationGracePeriod,omitempty"`
 	// Simple user password tuple generated secret name
 	// +optional
 	WorkGeneratedSecretName string `json:"workGeneratedSecretName"`
@@ -109,6 +203,64 @@ type PostgresqlUserRoleSpec struct {
 	// Note: Only attributes that aren't conflicting with operator are supported.
 	// +optional
 	RoleAttributes *PostgresqlUserRoleAttributes `json:"roleAttributes,omitempty"`
+	// Password policy
+	// This will be used to generate passwords in MANAGED mode and to validate imported passwords in PROVIDED mode.
+	// Note: If not set, engine configuration default password policy will be used if set.
+	// +optional
+	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty"`
+	// Suspended
+	// When enabled, current and old roles are set to NOLOGIN and their sessions are terminated.
+	// Password rotation is paused while suspended.
+	// Disabling it restores access with the same credentials.
+	// +optional
+	Suspended bool `json:"suspended,omitempty"`
+	// Blank generated secrets when suspended
+	// When enabled, generated secrets values are emptied while user role is suspended.
+	// +optional
+	BlankSecretsWhenSuspended bool `json:"blankSecretsWhenSuspended,omitempty"`
+}
+
+type OldPostgresRoleSessions struct {
+	// Old role
+	Role string `json:"role"`
+	// Engine configuration key where role is still used
+	Engine string `json:"engine"`
+	// Time when active sessions have been detected for the first time on this old role
+	DetectedTime string `json:"detectedTime"`
+	// Active sessions count
+	ActiveSessions int `json:"activeSessions"`
+	// Oldest active session start time
+	// +optional
+	OldestSessionStartTime string `json:"oldestSessionStartTime,omitempty"`
+}
+
+type OldPostgresRoleRotation struct {
+	// Old role
+	Role string `json:"role"`
+	// Time when role have been rotated and became an old role
+	RotatedTime string `json:"rotatedTime"`
+}
+
+type UserRoleTableGrantDatabase struct {
+	// Engine configuration key
+	Engine string `json:"engine"`
+	// Database name
+	Database string `json:"database"`
+	// Grants applied by operator in database
+	// +optional
+	Grants []*UserRoleAppliedTableGrant `json:"grants,omitempty"`
+}
+
+type UserRoleAppliedTableGrant struct {
+	// Schema name
+	Schema string `json:"schema"`
+	// Table name
+	Table string `json:"table"`
+	// Column name, empty for table level grants
+	// +optional
+	Column string `json:"column,omitempty"`
+	// Privilege
+	Privilege string `json:"privilege"`
 }
 
 type UserRoleStatusPhase string
@@ -116,6 +268,7 @@ type UserRoleStatusPhase string
 const UserRoleNoPhase UserRoleStatusPhase = ""
 const UserRoleFailedPhase UserRoleStatusPhase = "Failed"
 const UserRoleCreatedPhase UserRoleStatusPhase = "Created"
+const UserRoleSuspendedPhase UserRoleStatusPhase = "Suspended"
 
 // PostgresqlUserRoleStatus defines the observed state of PostgresqlUserRole.
 type PostgresqlUserRoleStatus struct {
@@ -139,9 +292,24 @@ type PostgresqlUserRoleStatus struct {
 	// Postgres old roles to cleanup
 	// +optional
 	OldPostgresRoles []string `json:"oldPostgresRoles"`
+	// Old roles active sessions details
+	// +optional
+	OldPostgresRolesSessions []*OldPostgresRoleSessions `json:"oldPostgresRolesSessions,omitempty"`
+	// Old roles rotation times used as termination grace period start
+	// +optional
+	OldPostgresRolesRotations []*OldPostgresRoleRotation `json:"oldPostgresRolesRotations,omitempty"`
 	// Last password changed time
 	// +optional
 	LastPasswordChangedTime string `json:"lastPasswordChangedTime"`
+	// Password expiration time set with VALID UNTIL attribute
+	// +optional
+	PasswordExpirationTime string `json:"passwordExpirationTime,omitempty"`
+	// Suspension time
+	// +optional
+	SuspendedTime string `json:"suspendedTime,omitempty"`
+	// Databases where table grants have been applied
+	// +optional
+	TableGrantDatabases []*UserRoleTableGrantDatabase `json:"tableGrantDatabases,omitempty"`
 }
 
 //+kubebuilder:object:root=true
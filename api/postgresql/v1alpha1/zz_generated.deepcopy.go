@@ -22,9 +22,129 @@ package v1alpha1
 
 import (
 	"github.com/easymile/postgresql-operator/api/postgresql/common"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseBlockingSession) DeepCopyInto(out *DatabaseBlockingSession) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseBlockingSession.
+func (in *DatabaseBlockingSession) DeepCopy() *DatabaseBlockingSession {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseBlockingSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseExtensionOptions) DeepCopyInto(out *DatabaseExtensionOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseExtensionOptions.
+func (in *DatabaseExtensionOptions) DeepCopy() *DatabaseExtensionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseExtensionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseExtensionVersionStatus) DeepCopyInto(out *DatabaseExtensionVersionStatus) {
+	*out = *in
+	if in.AvailableVersions != nil {
+		in, out := &in.AvailableVersions, &out.AvailableVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseExtensionVersionStatus.
+func (in *DatabaseExtensionVersionStatus) DeepCopy() *DatabaseExtensionVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseExtensionVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseExtensionsList) DeepCopyInto(out *DatabaseExtensionsList) {
+	*out = *in
+	if in.List != nil {
+		in, out := &in.List, &out.List
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]DatabaseExtensionOptions, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseExtensionsList.
+func (in *DatabaseExtensionsList) DeepCopy() *DatabaseExtensionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseExtensionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseInitSource) DeepCopyInto(out *DatabaseInitSource) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(common.CRLink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseInitSource.
+func (in *DatabaseInitSource) DeepCopy() *DatabaseInitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseInitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseInitializationStatus) DeepCopyInto(out *DatabaseInitializationStatus) {
+	*out = *in
+	if in.SourceRoles != nil {
+		in, out := &in.SourceRoles, &out.SourceRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseInitializationStatus.
+func (in *DatabaseInitializationStatus) DeepCopy() *DatabaseInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseModulesList) DeepCopyInto(out *DatabaseModulesList) {
 	*out = *in
@@ -45,6 +165,36 @@ func (in *DatabaseModulesList) DeepCopy() *DatabaseModulesList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSoftDelete) DeepCopyInto(out *DatabaseSoftDelete) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSoftDelete.
+func (in *DatabaseSoftDelete) DeepCopy() *DatabaseSoftDelete {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSoftDelete)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseTombstone) DeepCopyInto(out *DatabaseTombstone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseTombstone.
+func (in *DatabaseTombstone) DeepCopy() *DatabaseTombstone {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseTombstone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericUserConnection) DeepCopyInto(out *GenericUserConnection) {
 	*out = *in
@@ -61,26 +211,76 @@ func (in *GenericUserConnection) DeepCopy() *GenericUserConnection {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlDatabase) DeepCopyInto(out *PostgresqlDatabase) {
+func (in *OldPostgresRoleRotation) DeepCopyInto(out *OldPostgresRoleRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OldPostgresRoleRotation.
+func (in *OldPostgresRoleRotation) DeepCopy() *OldPostgresRoleRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(OldPostgresRoleRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OldPostgresRoleSessions) DeepCopyInto(out *OldPostgresRoleSessions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OldPostgresRoleSessions.
+func (in *OldPostgresRoleSessions) DeepCopy() *OldPostgresRoleSessions {
+	if in == nil {
+		return nil
+	}
+	out := new(OldPostgresRoleSessions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	if in.CharacterClasses != nil {
+		in, out := &in.CharacterClasses, &out.CharacterClasses
+		*out = make([]PasswordCharacterClass, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackup) DeepCopyInto(out *PostgresqlBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabase.
-func (in *PostgresqlDatabase) DeepCopy() *PostgresqlDatabase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackup.
+func (in *PostgresqlBackup) DeepCopy() *PostgresqlBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlDatabase)
+	out := new(PostgresqlBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlDatabase) DeepCopyObject() runtime.Object {
+func (in *PostgresqlBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -88,31 +288,56 @@ func (in *PostgresqlDatabase) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlDatabaseList) DeepCopyInto(out *PostgresqlDatabaseList) {
+func (in *PostgresqlBackupDestination) DeepCopyInto(out *PostgresqlBackupDestination) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PostgresqlBackupPVCDestination)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(PostgresqlBackupS3Destination)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupDestination.
+func (in *PostgresqlBackupDestination) DeepCopy() *PostgresqlBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackupList) DeepCopyInto(out *PostgresqlBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PostgresqlDatabase, len(*in))
+		*out = make([]PostgresqlBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseList.
-func (in *PostgresqlDatabaseList) DeepCopy() *PostgresqlDatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupList.
+func (in *PostgresqlBackupList) DeepCopy() *PostgresqlBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlDatabaseList)
+	out := new(PostgresqlBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlDatabaseList) DeepCopyObject() runtime.Object {
+func (in *PostgresqlBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -120,55 +345,57 @@ func (in *PostgresqlDatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlDatabaseSpec) DeepCopyInto(out *PostgresqlDatabaseSpec) {
+func (in *PostgresqlBackupPVCDestination) DeepCopyInto(out *PostgresqlBackupPVCDestination) {
 	*out = *in
-	in.Schemas.DeepCopyInto(&out.Schemas)
-	in.Extensions.DeepCopyInto(&out.Extensions)
-	if in.EngineConfiguration != nil {
-		in, out := &in.EngineConfiguration, &out.EngineConfiguration
-		*out = new(common.CRLink)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseSpec.
-func (in *PostgresqlDatabaseSpec) DeepCopy() *PostgresqlDatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupPVCDestination.
+func (in *PostgresqlBackupPVCDestination) DeepCopy() *PostgresqlBackupPVCDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlDatabaseSpec)
+	out := new(PostgresqlBackupPVCDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlDatabaseStatus) DeepCopyInto(out *PostgresqlDatabaseStatus) {
+func (in *PostgresqlBackupRetention) DeepCopyInto(out *PostgresqlBackupRetention) {
 	*out = *in
-	out.Roles = in.Roles
-	if in.Schemas != nil {
-		in, out := &in.Schemas, &out.Schemas
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int)
+		**out = **in
 	}
-	if in.Extensions != nil {
-		in, out := &in.Extensions, &out.Extensions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupRetention.
+func (in *PostgresqlBackupRetention) DeepCopy() *PostgresqlBackupRetention {
+	if in == nil {
+		return nil
 	}
+	out := new(PostgresqlBackupRetention)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseStatus.
-func (in *PostgresqlDatabaseStatus) DeepCopy() *PostgresqlDatabaseStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackupS3Destination) DeepCopyInto(out *PostgresqlBackupS3Destination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupS3Destination.
+func (in *PostgresqlBackupS3Destination) DeepCopy() *PostgresqlBackupS3Destination {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlDatabaseStatus)
+	out := new(PostgresqlBackupS3Destination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlEngineConfiguration) DeepCopyInto(out *PostgresqlEngineConfiguration) {
+func (in *PostgresqlBackupSchedule) DeepCopyInto(out *PostgresqlBackupSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -176,18 +403,18 @@ func (in *PostgresqlEngineConfiguration) DeepCopyInto(out *PostgresqlEngineConfi
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfiguration.
-func (in *PostgresqlEngineConfiguration) DeepCopy() *PostgresqlEngineConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupSchedule.
+func (in *PostgresqlBackupSchedule) DeepCopy() *PostgresqlBackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlEngineConfiguration)
+	out := new(PostgresqlBackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlEngineConfiguration) DeepCopyObject() runtime.Object {
+func (in *PostgresqlBackupSchedule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -195,31 +422,31 @@ func (in *PostgresqlEngineConfiguration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlEngineConfigurationList) DeepCopyInto(out *PostgresqlEngineConfigurationList) {
+func (in *PostgresqlBackupScheduleList) DeepCopyInto(out *PostgresqlBackupScheduleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PostgresqlEngineConfiguration, len(*in))
+		*out = make([]PostgresqlBackupSchedule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationList.
-func (in *PostgresqlEngineConfigurationList) DeepCopy() *PostgresqlEngineConfigurationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupScheduleList.
+func (in *PostgresqlBackupScheduleList) DeepCopy() *PostgresqlBackupScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlEngineConfigurationList)
+	out := new(PostgresqlBackupScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlEngineConfigurationList) DeepCopyObject() runtime.Object {
+func (in *PostgresqlBackupScheduleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -227,42 +454,1040 @@ func (in *PostgresqlEngineConfigurationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlEngineConfigurationSpec) DeepCopyInto(out *PostgresqlEngineConfigurationSpec) {
+func (in *PostgresqlBackupScheduleSpec) DeepCopyInto(out *PostgresqlBackupScheduleSpec) {
 	*out = *in
-	if in.UserConnections != nil {
-		in, out := &in.UserConnections, &out.UserConnections
-		*out = new(UserConnections)
+	if in.BackupSpec != nil {
+		in, out := &in.BackupSpec, &out.BackupSpec
+		*out = new(PostgresqlBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(PostgresqlBackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupScheduleSpec.
+func (in *PostgresqlBackupScheduleSpec) DeepCopy() *PostgresqlBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackupScheduleStatus) DeepCopyInto(out *PostgresqlBackupScheduleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupScheduleStatus.
+func (in *PostgresqlBackupScheduleStatus) DeepCopy() *PostgresqlBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackupSpec) DeepCopyInto(out *PostgresqlBackupSpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(PostgresqlBackupDestination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupSpec.
+func (in *PostgresqlBackupSpec) DeepCopy() *PostgresqlBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlBackupStatus) DeepCopyInto(out *PostgresqlBackupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlBackupStatus.
+func (in *PostgresqlBackupStatus) DeepCopy() *PostgresqlBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlDatabase) DeepCopyInto(out *PostgresqlDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabase.
+func (in *PostgresqlDatabase) DeepCopy() *PostgresqlDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlDatabaseList) DeepCopyInto(out *PostgresqlDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseList.
+func (in *PostgresqlDatabaseList) DeepCopy() *PostgresqlDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlDatabaseSpec) DeepCopyInto(out *PostgresqlDatabaseSpec) {
+	*out = *in
+	if in.SoftDelete != nil {
+		in, out := &in.SoftDelete, &out.SoftDelete
+		*out = new(DatabaseSoftDelete)
+		**out = **in
+	}
+	in.Schemas.DeepCopyInto(&out.Schemas)
+	in.Extensions.DeepCopyInto(&out.Extensions)
+	if in.EngineConfiguration != nil {
+		in, out := &in.EngineConfiguration, &out.EngineConfiguration
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.InitFrom != nil {
+		in, out := &in.InitFrom, &out.InitFrom
+		*out = new(DatabaseInitSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseSpec.
+func (in *PostgresqlDatabaseSpec) DeepCopy() *PostgresqlDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlDatabaseStatus) DeepCopyInto(out *PostgresqlDatabaseStatus) {
+	*out = *in
+	out.Roles = in.Roles
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtensionVersions != nil {
+		in, out := &in.ExtensionVersions, &out.ExtensionVersions
+		*out = make([]DatabaseExtensionVersionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Initialization != nil {
+		in, out := &in.Initialization, &out.Initialization
+		*out = new(DatabaseInitializationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockingSessions != nil {
+		in, out := &in.BlockingSessions, &out.BlockingSessions
+		*out = make([]DatabaseBlockingSession, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlDatabaseStatus.
+func (in *PostgresqlDatabaseStatus) DeepCopy() *PostgresqlDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlEngineConfiguration) DeepCopyInto(out *PostgresqlEngineConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfiguration.
+func (in *PostgresqlEngineConfiguration) DeepCopy() *PostgresqlEngineConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlEngineConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlEngineConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlEngineConfigurationList) DeepCopyInto(out *PostgresqlEngineConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlEngineConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationList.
+func (in *PostgresqlEngineConfigurationList) DeepCopy() *PostgresqlEngineConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlEngineConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlEngineConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlEngineConfigurationSpec) DeepCopyInto(out *PostgresqlEngineConfigurationSpec) {
+	*out = *in
+	if in.UserConnections != nil {
+		in, out := &in.UserConnections, &out.UserConnections
+		*out = new(UserConnections)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultPasswordPolicy != nil {
+		in, out := &in.DefaultPasswordPolicy, &out.DefaultPasswordPolicy
+		*out = new(PasswordPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedUserRoleAttributes != nil {
+		in, out := &in.AllowedUserRoleAttributes, &out.AllowedUserRoleAttributes
+		*out = make([]DangerousUserRoleAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationSpec.
+func (in *PostgresqlEngineConfigurationSpec) DeepCopy() *PostgresqlEngineConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlEngineConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlEngineConfigurationStatus) DeepCopyInto(out *PostgresqlEngineConfigurationStatus) {
+	*out = *in
+	if in.Tombstones != nil {
+		in, out := &in.Tombstones, &out.Tombstones
+		*out = make([]DatabaseTombstone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationStatus.
+func (in *PostgresqlEngineConfigurationStatus) DeepCopy() *PostgresqlEngineConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlEngineConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlMigration) DeepCopyInto(out *PostgresqlMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlMigration.
+func (in *PostgresqlMigration) DeepCopy() *PostgresqlMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlMigrationAppliedVersion) DeepCopyInto(out *PostgresqlMigrationAppliedVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlMigrationAppliedVersion.
+func (in *PostgresqlMigrationAppliedVersion) DeepCopy() *PostgresqlMigrationAppliedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlMigrationAppliedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlMigrationList) DeepCopyInto(out *PostgresqlMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlMigrationList.
+func (in *PostgresqlMigrationList) DeepCopy() *PostgresqlMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlMigrationSpec) DeepCopyInto(out *PostgresqlMigrationSpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlMigrationSpec.
+func (in *PostgresqlMigrationSpec) DeepCopy() *PostgresqlMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlMigrationStatus) DeepCopyInto(out *PostgresqlMigrationStatus) {
+	*out = *in
+	if in.AppliedVersions != nil {
+		in, out := &in.AppliedVersions, &out.AppliedVersions
+		*out = make([]*PostgresqlMigrationAppliedVersion, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PostgresqlMigrationAppliedVersion)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlMigrationStatus.
+func (in *PostgresqlMigrationStatus) DeepCopy() *PostgresqlMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublication) DeepCopyInto(out *PostgresqlPublication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublication.
+func (in *PostgresqlPublication) DeepCopy() *PostgresqlPublication {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlPublication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationCDC) DeepCopyInto(out *PostgresqlPublicationCDC) {
+	*out = *in
+	if in.ReplicaIdentities != nil {
+		in, out := &in.ReplicaIdentities, &out.ReplicaIdentities
+		*out = make([]*PostgresqlPublicationCDCReplicaIdentity, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PostgresqlPublicationCDCReplicaIdentity)
+				**out = **in
+			}
+		}
+	}
+	if in.ReplicationUser != nil {
+		in, out := &in.ReplicationUser, &out.ReplicationUser
+		*out = new(PostgresqlPublicationCDCReplicationUser)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationCDC.
+func (in *PostgresqlPublicationCDC) DeepCopy() *PostgresqlPublicationCDC {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationCDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationCDCReplicaIdentity) DeepCopyInto(out *PostgresqlPublicationCDCReplicaIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationCDCReplicaIdentity.
+func (in *PostgresqlPublicationCDCReplicaIdentity) DeepCopy() *PostgresqlPublicationCDCReplicaIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationCDCReplicaIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationCDCReplicationUser) DeepCopyInto(out *PostgresqlPublicationCDCReplicationUser) {
+	*out = *in
+	if in.ExtraConnectionURLParameters != nil {
+		in, out := &in.ExtraConnectionURLParameters, &out.ExtraConnectionURLParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationCDCReplicationUser.
+func (in *PostgresqlPublicationCDCReplicationUser) DeepCopy() *PostgresqlPublicationCDCReplicationUser {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationCDCReplicationUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationList) DeepCopyInto(out *PostgresqlPublicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlPublication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationList.
+func (in *PostgresqlPublicationList) DeepCopy() *PostgresqlPublicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlPublicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationOwner) DeepCopyInto(out *PostgresqlPublicationOwner) {
+	*out = *in
+	if in.UserRole != nil {
+		in, out := &in.UserRole, &out.UserRole
+		*out = new(common.CRLink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationOwner.
+func (in *PostgresqlPublicationOwner) DeepCopy() *PostgresqlPublicationOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationSlotMonitoring) DeepCopyInto(out *PostgresqlPublicationSlotMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationSlotMonitoring.
+func (in *PostgresqlPublicationSlotMonitoring) DeepCopy() *PostgresqlPublicationSlotMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationSlotMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationSlotSafeguard) DeepCopyInto(out *PostgresqlPublicationSlotSafeguard) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationSlotSafeguard.
+func (in *PostgresqlPublicationSlotSafeguard) DeepCopy() *PostgresqlPublicationSlotSafeguard {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationSlotSafeguard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationSpec) DeepCopyInto(out *PostgresqlPublicationSpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.TablesInSchema != nil {
+		in, out := &in.TablesInSchema, &out.TablesInSchema
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]*PostgresqlPublicationTable, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PostgresqlPublicationTable)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TableSelector != nil {
+		in, out := &in.TableSelector, &out.TableSelector
+		*out = new(PostgresqlPublicationTableSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WithParameters != nil {
+		in, out := &in.WithParameters, &out.WithParameters
+		*out = new(PostgresqlPublicationWith)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(PostgresqlPublicationOwner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SlotMonitoring != nil {
+		in, out := &in.SlotMonitoring, &out.SlotMonitoring
+		*out = new(PostgresqlPublicationSlotMonitoring)
+		**out = **in
+	}
+	if in.SlotSafeguard != nil {
+		in, out := &in.SlotSafeguard, &out.SlotSafeguard
+		*out = new(PostgresqlPublicationSlotSafeguard)
+		**out = **in
+	}
+	if in.CDC != nil {
+		in, out := &in.CDC, &out.CDC
+		*out = new(PostgresqlPublicationCDC)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationSpec.
+func (in *PostgresqlPublicationSpec) DeepCopy() *PostgresqlPublicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationStatus) DeepCopyInto(out *PostgresqlPublicationStatus) {
+	*out = *in
+	if in.AllTables != nil {
+		in, out := &in.AllTables, &out.AllTables
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicationSlot != nil {
+		in, out := &in.ReplicationSlot, &out.ReplicationSlot
+		*out = new(ReplicationSlotHealthStatus)
+		**out = **in
+	}
+	if in.SlotSafeguard != nil {
+		in, out := &in.SlotSafeguard, &out.SlotSafeguard
+		*out = new(PublicationSlotSafeguardStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiscoveredTables != nil {
+		in, out := &in.DiscoveredTables, &out.DiscoveredTables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TablesWithoutReplicaIdentity != nil {
+		in, out := &in.TablesWithoutReplicaIdentity, &out.TablesWithoutReplicaIdentity
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationStatus.
+func (in *PostgresqlPublicationStatus) DeepCopy() *PostgresqlPublicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationTable) DeepCopyInto(out *PostgresqlPublicationTable) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.AdditionalWhere != nil {
+		in, out := &in.AdditionalWhere, &out.AdditionalWhere
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationTable.
+func (in *PostgresqlPublicationTable) DeepCopy() *PostgresqlPublicationTable {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationTableSelector) DeepCopyInto(out *PostgresqlPublicationTableSelector) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationTableSelector.
+func (in *PostgresqlPublicationTableSelector) DeepCopy() *PostgresqlPublicationTableSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationTableSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlPublicationWith) DeepCopyInto(out *PostgresqlPublicationWith) {
+	*out = *in
+	if in.PublishViaPartitionRoot != nil {
+		in, out := &in.PublishViaPartitionRoot, &out.PublishViaPartitionRoot
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationWith.
+func (in *PostgresqlPublicationWith) DeepCopy() *PostgresqlPublicationWith {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlPublicationWith)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlReplicationSlot) DeepCopyInto(out *PostgresqlReplicationSlot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlReplicationSlot.
+func (in *PostgresqlReplicationSlot) DeepCopy() *PostgresqlReplicationSlot {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlReplicationSlot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlReplicationSlot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlReplicationSlotList) DeepCopyInto(out *PostgresqlReplicationSlotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlReplicationSlot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlReplicationSlotList.
+func (in *PostgresqlReplicationSlotList) DeepCopy() *PostgresqlReplicationSlotList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlReplicationSlotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlReplicationSlotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlReplicationSlotSpec) DeepCopyInto(out *PostgresqlReplicationSlotSpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.EngineConfiguration != nil {
+		in, out := &in.EngineConfiguration, &out.EngineConfiguration
+		*out = new(common.CRLink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlReplicationSlotSpec.
+func (in *PostgresqlReplicationSlotSpec) DeepCopy() *PostgresqlReplicationSlotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlReplicationSlotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlReplicationSlotStatus) DeepCopyInto(out *PostgresqlReplicationSlotStatus) {
+	*out = *in
+	if in.ReplicationSlot != nil {
+		in, out := &in.ReplicationSlot, &out.ReplicationSlot
+		*out = new(ReplicationSlotHealthStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlReplicationSlotStatus.
+func (in *PostgresqlReplicationSlotStatus) DeepCopy() *PostgresqlReplicationSlotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlReplicationSlotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlRowLevelSecurityPolicy) DeepCopyInto(out *PostgresqlRowLevelSecurityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlRowLevelSecurityPolicy.
+func (in *PostgresqlRowLevelSecurityPolicy) DeepCopy() *PostgresqlRowLevelSecurityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlRowLevelSecurityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlRowLevelSecurityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlRowLevelSecurityPolicyList) DeepCopyInto(out *PostgresqlRowLevelSecurityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresqlRowLevelSecurityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlRowLevelSecurityPolicyList.
+func (in *PostgresqlRowLevelSecurityPolicyList) DeepCopy() *PostgresqlRowLevelSecurityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlRowLevelSecurityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresqlRowLevelSecurityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlRowLevelSecurityPolicySpec) DeepCopyInto(out *PostgresqlRowLevelSecurityPolicySpec) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(common.CRLink)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DatabaseRoles != nil {
+		in, out := &in.DatabaseRoles, &out.DatabaseRoles
+		*out = make([]PolicyDatabaseRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.Using != nil {
+		in, out := &in.Using, &out.Using
+		*out = new(string)
+		**out = **in
+	}
+	if in.WithCheck != nil {
+		in, out := &in.WithCheck, &out.WithCheck
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationSpec.
-func (in *PostgresqlEngineConfigurationSpec) DeepCopy() *PostgresqlEngineConfigurationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlRowLevelSecurityPolicySpec.
+func (in *PostgresqlRowLevelSecurityPolicySpec) DeepCopy() *PostgresqlRowLevelSecurityPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlEngineConfigurationSpec)
+	out := new(PostgresqlRowLevelSecurityPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlEngineConfigurationStatus) DeepCopyInto(out *PostgresqlEngineConfigurationStatus) {
+func (in *PostgresqlRowLevelSecurityPolicyStatus) DeepCopyInto(out *PostgresqlRowLevelSecurityPolicyStatus) {
 	*out = *in
+	if in.Using != nil {
+		in, out := &in.Using, &out.Using
+		*out = new(string)
+		**out = **in
+	}
+	if in.WithCheck != nil {
+		in, out := &in.WithCheck, &out.WithCheck
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlEngineConfigurationStatus.
-func (in *PostgresqlEngineConfigurationStatus) DeepCopy() *PostgresqlEngineConfigurationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlRowLevelSecurityPolicyStatus.
+func (in *PostgresqlRowLevelSecurityPolicyStatus) DeepCopy() *PostgresqlRowLevelSecurityPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlEngineConfigurationStatus)
+	out := new(PostgresqlRowLevelSecurityPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublication) DeepCopyInto(out *PostgresqlPublication) {
+func (in *PostgresqlSequenceSync) DeepCopyInto(out *PostgresqlSequenceSync) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -270,18 +1495,18 @@ func (in *PostgresqlPublication) DeepCopyInto(out *PostgresqlPublication) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublication.
-func (in *PostgresqlPublication) DeepCopy() *PostgresqlPublication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlSequenceSync.
+func (in *PostgresqlSequenceSync) DeepCopy() *PostgresqlSequenceSync {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlPublication)
+	out := new(PostgresqlSequenceSync)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlPublication) DeepCopyObject() runtime.Object {
+func (in *PostgresqlSequenceSync) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -289,31 +1514,31 @@ func (in *PostgresqlPublication) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublicationList) DeepCopyInto(out *PostgresqlPublicationList) {
+func (in *PostgresqlSequenceSyncList) DeepCopyInto(out *PostgresqlSequenceSyncList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PostgresqlPublication, len(*in))
+		*out = make([]PostgresqlSequenceSync, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationList.
-func (in *PostgresqlPublicationList) DeepCopy() *PostgresqlPublicationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlSequenceSyncList.
+func (in *PostgresqlSequenceSyncList) DeepCopy() *PostgresqlSequenceSyncList {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlPublicationList)
+	out := new(PostgresqlSequenceSyncList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresqlPublicationList) DeepCopyObject() runtime.Object {
+func (in *PostgresqlSequenceSyncList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -321,111 +1546,57 @@ func (in *PostgresqlPublicationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublicationSpec) DeepCopyInto(out *PostgresqlPublicationSpec) {
+func (in *PostgresqlSequenceSyncSpec) DeepCopyInto(out *PostgresqlSequenceSyncSpec) {
 	*out = *in
-	if in.Database != nil {
-		in, out := &in.Database, &out.Database
+	if in.Publication != nil {
+		in, out := &in.Publication, &out.Publication
 		*out = new(common.CRLink)
 		**out = **in
 	}
-	if in.TablesInSchema != nil {
-		in, out := &in.TablesInSchema, &out.TablesInSchema
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tables != nil {
-		in, out := &in.Tables, &out.Tables
-		*out = make([]*PostgresqlPublicationTable, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(PostgresqlPublicationTable)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.WithParameters != nil {
-		in, out := &in.WithParameters, &out.WithParameters
-		*out = new(PostgresqlPublicationWith)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationSpec.
-func (in *PostgresqlPublicationSpec) DeepCopy() *PostgresqlPublicationSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(PostgresqlPublicationSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublicationStatus) DeepCopyInto(out *PostgresqlPublicationStatus) {
-	*out = *in
-	if in.AllTables != nil {
-		in, out := &in.AllTables, &out.AllTables
-		*out = new(bool)
+	if in.TargetDatabase != nil {
+		in, out := &in.TargetDatabase, &out.TargetDatabase
+		*out = new(common.CRLink)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationStatus.
-func (in *PostgresqlPublicationStatus) DeepCopy() *PostgresqlPublicationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlSequenceSyncSpec.
+func (in *PostgresqlSequenceSyncSpec) DeepCopy() *PostgresqlSequenceSyncSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlPublicationStatus)
+	out := new(PostgresqlSequenceSyncSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublicationTable) DeepCopyInto(out *PostgresqlPublicationTable) {
+func (in *PostgresqlSequenceSyncStatus) DeepCopyInto(out *PostgresqlSequenceSyncStatus) {
 	*out = *in
-	if in.Columns != nil {
-		in, out := &in.Columns, &out.Columns
-		*out = new([]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]string, len(*in))
-			copy(*out, *in)
+	if in.Sequences != nil {
+		in, out := &in.Sequences, &out.Sequences
+		*out = make([]*SequenceSyncResult, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SequenceSyncResult)
+				**out = **in
+			}
 		}
 	}
-	if in.AdditionalWhere != nil {
-		in, out := &in.AdditionalWhere, &out.AdditionalWhere
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationTable.
-func (in *PostgresqlPublicationTable) DeepCopy() *PostgresqlPublicationTable {
-	if in == nil {
-		return nil
-	}
-	out := new(PostgresqlPublicationTable)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresqlPublicationWith) DeepCopyInto(out *PostgresqlPublicationWith) {
-	*out = *in
-	if in.PublishViaPartitionRoot != nil {
-		in, out := &in.PublishViaPartitionRoot, &out.PublishViaPartitionRoot
-		*out = new(bool)
-		**out = **in
+	if in.SkippedSequences != nil {
+		in, out := &in.SkippedSequences, &out.SkippedSequences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlPublicationWith.
-func (in *PostgresqlPublicationWith) DeepCopy() *PostgresqlPublicationWith {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlSequenceSyncStatus.
+func (in *PostgresqlSequenceSyncStatus) DeepCopy() *PostgresqlSequenceSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresqlPublicationWith)
+	out := new(PostgresqlSequenceSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -475,6 +1646,26 @@ func (in *PostgresqlUserRoleAttributes) DeepCopyInto(out *PostgresqlUserRoleAttr
 		*out = new(int)
 		**out = **in
 	}
+	if in.CreateDB != nil {
+		in, out := &in.CreateDB, &out.CreateDB
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateRole != nil {
+		in, out := &in.CreateRole, &out.CreateRole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Inherit != nil {
+		in, out := &in.Inherit, &out.Inherit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlUserRoleAttributes.
@@ -534,6 +1725,17 @@ func (in *PostgresqlUserRolePrivilege) DeepCopyInto(out *PostgresqlUserRolePrivi
 			(*out)[key] = val
 		}
 	}
+	if in.TableGrants != nil {
+		in, out := &in.TableGrants, &out.TableGrants
+		*out = make([]*PostgresqlUserRoleTableGrant, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PostgresqlUserRoleTableGrant)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlUserRolePrivilege.
@@ -565,6 +1767,11 @@ func (in *PostgresqlUserRoleSpec) DeepCopyInto(out *PostgresqlUserRoleSpec) {
 		*out = new(PostgresqlUserRoleAttributes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(PasswordPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlUserRoleSpec.
@@ -585,6 +1792,39 @@ func (in *PostgresqlUserRoleStatus) DeepCopyInto(out *PostgresqlUserRoleStatus)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OldPostgresRolesSessions != nil {
+		in, out := &in.OldPostgresRolesSessions, &out.OldPostgresRolesSessions
+		*out = make([]*OldPostgresRoleSessions, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OldPostgresRoleSessions)
+				**out = **in
+			}
+		}
+	}
+	if in.OldPostgresRolesRotations != nil {
+		in, out := &in.OldPostgresRolesRotations, &out.OldPostgresRolesRotations
+		*out = make([]*OldPostgresRoleRotation, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OldPostgresRoleRotation)
+				**out = **in
+			}
+		}
+	}
+	if in.TableGrantDatabases != nil {
+		in, out := &in.TableGrantDatabases, &out.TableGrantDatabases
+		*out = make([]*UserRoleTableGrantDatabase, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserRoleTableGrantDatabase)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlUserRoleStatus.
@@ -597,6 +1837,96 @@ func (in *PostgresqlUserRoleStatus) DeepCopy() *PostgresqlUserRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresqlUserRoleTableGrant) DeepCopyInto(out *PostgresqlUserRoleTableGrant) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]TableGrantPrivilege, len(*in))
+		copy(*out, *in)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresqlUserRoleTableGrant.
+func (in *PostgresqlUserRoleTableGrant) DeepCopy() *PostgresqlUserRoleTableGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresqlUserRoleTableGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationSlotSafeguardActionStatus) DeepCopyInto(out *PublicationSlotSafeguardActionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationSlotSafeguardActionStatus.
+func (in *PublicationSlotSafeguardActionStatus) DeepCopy() *PublicationSlotSafeguardActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationSlotSafeguardActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicationSlotSafeguardStatus) DeepCopyInto(out *PublicationSlotSafeguardStatus) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]PublicationSlotSafeguardActionStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationSlotSafeguardStatus.
+func (in *PublicationSlotSafeguardStatus) DeepCopy() *PublicationSlotSafeguardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicationSlotSafeguardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSlotHealthStatus) DeepCopyInto(out *ReplicationSlotHealthStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSlotHealthStatus.
+func (in *ReplicationSlotHealthStatus) DeepCopy() *ReplicationSlotHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSlotHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SequenceSyncResult) DeepCopyInto(out *SequenceSyncResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SequenceSyncResult.
+func (in *SequenceSyncResult) DeepCopy() *SequenceSyncResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SequenceSyncResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusPostgresRoles) DeepCopyInto(out *StatusPostgresRoles) {
 	*out = *in
@@ -658,3 +1988,44 @@ func (in *UserConnections) DeepCopy() *UserConnections {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserRoleAppliedTableGrant) DeepCopyInto(out *UserRoleAppliedTableGrant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserRoleAppliedTableGrant.
+func (in *UserRoleAppliedTableGrant) DeepCopy() *UserRoleAppliedTableGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(UserRoleAppliedTableGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserRoleTableGrantDatabase) DeepCopyInto(out *UserRoleTableGrantDatabase) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]*UserRoleAppliedTableGrant, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserRoleAppliedTableGrant)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserRoleTableGrantDatabase.
+func (in *UserRoleTableGrantDatabase) DeepCopy() *UserRoleTableGrantDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(UserRoleTableGrantDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -51,11 +51,51 @@ var (
 		},
 		[]string{"controller", "namespace", "name"},
 	)
+	userRoleOldRoleActiveSessions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "postgresqluserrole_old_role_active_sessions",
+			Help: "Number of active sessions still opened with an old role waiting for deletion.",
+		},
+		[]string{"namespace", "name", "role", "engine"},
+	)
+	userRoleOldRoleActiveSessionsAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "postgresqluserrole_old_role_active_sessions_age_seconds",
+			Help: "Time in seconds since active sessions have been detected on an old role waiting for deletion.",
+		},
+		[]string{"namespace", "name", "role", "engine"},
+	)
+	publicationReplicationSlotActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "postgresqlpublication_replication_slot_active",
+			Help: "Is a consumer connected to the publication replication slot (1 for active, 0 for inactive).",
+		},
+		[]string{"namespace", "name", "slot", "engine"},
+	)
+	publicationReplicationSlotRetainedWALBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "postgresqlpublication_replication_slot_retained_wal_bytes",
+			Help: "WAL size in bytes retained by the publication replication slot.",
+		},
+		[]string{"namespace", "name", "slot", "engine"},
+	)
+	publicationReplicationSlotConfirmedFlushLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "postgresqlpublication_replication_slot_confirmed_flush_lag_bytes",
+			Help: "WAL size in bytes not yet confirmed by the publication replication slot consumer.",
+		},
+		[]string{"namespace", "name", "slot", "engine"},
+	)
 )
 
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(controllerRuntimeDetailedErrorTotal)
+	metrics.Registry.MustRegister(userRoleOldRoleActiveSessions)
+	metrics.Registry.MustRegister(userRoleOldRoleActiveSessionsAge)
+	metrics.Registry.MustRegister(publicationReplicationSlotActive)
+	metrics.Registry.MustRegister(publicationReplicationSlotRetainedWALBytes)
+	metrics.Registry.MustRegister(publicationReplicationSlotConfirmedFlushLag)
 
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
@@ -66,7 +106,7 @@ func init() {
 func main() {
 	var metricsAddr, probeAddr, resyncPeriodStr, reconcileTimeoutStr string
 
-	var enableLeaderElection bool
+	var enableLeaderElection, enableWebhooks bool
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -76,6 +116,10 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Enable validating webhooks. "+
+			"Enabling this requires webhook server certificates to be mounted.")
+
 	opts := zap.Options{
 		Development: false,
 	}
@@ -179,6 +223,8 @@ func main() {
 			"postgresql.easymile.com",
 		),
 		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		OldRoleActiveSessions:               userRoleOldRoleActiveSessions,
+		OldRoleActiveSessionsAge:            userRoleOldRoleActiveSessionsAge,
 		ControllerName:                      "postgresqluserrole",
 		ReconcileTimeout:                    reconcileTimeout,
 	}).SetupWithManager(mgr); err != nil {
@@ -199,12 +245,144 @@ func main() {
 			"postgresql.easymile.com",
 		),
 		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ReplicationSlotActive:               publicationReplicationSlotActive,
+		ReplicationSlotRetainedWALBytes:     publicationReplicationSlotRetainedWALBytes,
+		ReplicationSlotConfirmedFlushLag:    publicationReplicationSlotConfirmedFlushLag,
 		ControllerName:                      "postgresqlpublication",
 		ReconcileTimeout:                    reconcileTimeout,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlPublication")
 		os.Exit(1)
 	}
+	if err = (&postgresqlcontrollers.PostgresqlRowLevelSecurityPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlrowlevelsecuritypolicy-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlrowlevelsecuritypolicy",
+			"controllerKind",
+			"PostgresqlRowLevelSecurityPolicy",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlrowlevelsecuritypolicy",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlRowLevelSecurityPolicy")
+		os.Exit(1)
+	}
+	if err = (&postgresqlcontrollers.PostgresqlMigrationReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlmigration-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlmigration",
+			"controllerKind",
+			"PostgresqlMigration",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlmigration",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlMigration")
+		os.Exit(1)
+	}
+	if err = (&postgresqlcontrollers.PostgresqlBackupReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlbackup-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlbackup",
+			"controllerKind",
+			"PostgresqlBackup",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlbackup",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlBackup")
+		os.Exit(1)
+	}
+	if err = (&postgresqlcontrollers.PostgresqlBackupScheduleReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlbackupschedule-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlbackupschedule",
+			"controllerKind",
+			"PostgresqlBackupSchedule",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlbackupschedule",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlBackupSchedule")
+		os.Exit(1)
+	}
+	if err = (&postgresqlcontrollers.PostgresqlReplicationSlotReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlreplicationslot-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlreplicationslot",
+			"controllerKind",
+			"PostgresqlReplicationSlot",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlreplicationslot",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlReplicationSlot")
+		os.Exit(1)
+	}
+	if err = (&postgresqlcontrollers.PostgresqlSequenceSyncReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("postgresqlsequencesync-controller"),
+		Log: ctrl.Log.WithValues(
+			"controller",
+			"postgresqlsequencesync",
+			"controllerKind",
+			"PostgresqlSequenceSync",
+			"controllerGroup",
+			"postgresql.easymile.com",
+		),
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlsequencesync",
+		ReconcileTimeout:                    reconcileTimeout,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresqlSequenceSync")
+		os.Exit(1)
+	}
+	// Check if webhooks are enabled
+	if enableWebhooks {
+		if err = (&postgresqlv1alpha1.PostgresqlEngineConfiguration{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "PostgresqlEngineConfiguration")
+			os.Exit(1)
+		}
+		if err = (&postgresqlv1alpha1.PostgresqlDatabase{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "PostgresqlDatabase")
+			os.Exit(1)
+		}
+		if err = (&postgresqlv1alpha1.PostgresqlPublication{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "PostgresqlPublication")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
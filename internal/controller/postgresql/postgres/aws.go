@@ -168,3 +168,60 @@ func (c *awspg) ChangeAndDropOwnedBy(ctx context.Context, role, newOwner, databa
 
 	return c.pg.ChangeAndDropOwnedBy(ctx, role, newOwner, database)
 }
+
+func (c *awspg) CreatePublication(ctx context.Context, dbname string, builder *CreatePublicationBuilder) error {
+	// On AWS RDS the postgres user isn't really superuser so he doesn't have permissions
+	// to change publication owner unless he belongs to the new owner role
+	return c.runWithTemporaryRoleGrants(ctx, c.user, []string{builder.owner}, func() error {
+		return c.pg.CreatePublication(ctx, dbname, builder)
+	})
+}
+
+func (c *awspg) UpdatePublication(ctx context.Context, dbname, publicationName string, builder *UpdatePublicationBuilder) error {
+	// On AWS RDS the postgres user isn't really superuser so he doesn't have permissions
+	// to alter publication unless he belongs to the owner role
+	roles, err := c.getPublicationOwnerRoles(ctx, dbname, publicationName, "")
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return c.runWithTemporaryRoleGrants(ctx, c.user, roles, func() error {
+		return c.pg.UpdatePublication(ctx, dbname, publicationName, builder)
+	})
+}
+
+func (c *awspg) ChangePublicationOwner(ctx context.Context, dbname, publicationName, owner string) error {
+	// On AWS RDS the postgres user isn't really superuser so he doesn't have permissions
+	// to change publication owner unless he belongs to both roles
+	roles, err := c.getPublicationOwnerRoles(ctx, dbname, publicationName, owner)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return c.runWithTemporaryRoleGrants(ctx, c.user, roles, func() error {
+		return c.pg.ChangePublicationOwner(ctx, dbname, publicationName, owner)
+	})
+}
+
+func (c *awspg) GetPublicationSequences(ctx context.Context, db, publicationName string) ([]*SequenceValue, error) {
+	// On AWS RDS the postgres user isn't really superuser so he doesn't have permissions
+	// to read sequences unless he belongs to their owner roles
+	roles, err := c.getPublicationSequenceOwners(ctx, db, publicationName)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*SequenceValue
+
+	err = c.runWithTemporaryRoleGrants(ctx, c.user, roles, func() error {
+		var err2 error
+		res, err2 = c.pg.GetPublicationSequences(ctx, db, publicationName)
+
+		return err2
+	})
+
+	return res, err
+}
@@ -54,3 +54,56 @@ func (azpg *azurepg) CreateDB(ctx context.Context, dbname, role string) error {
 
 	return azpg.pg.CreateDB(ctx, dbname, role)
 }
+
+func (azpg *azurepg) CreatePublication(ctx context.Context, dbname string, builder *CreatePublicationBuilder) error {
+	// Have to add the master role to the owner role before we can transfer the publication owner
+	return azpg.runWithTemporaryRoleGrants(ctx, azpg.GetRoleForLogin(azpg.user), []string{builder.owner}, func() error {
+		return azpg.pg.CreatePublication(ctx, dbname, builder)
+	})
+}
+
+func (azpg *azurepg) UpdatePublication(ctx context.Context, dbname, publicationName string, builder *UpdatePublicationBuilder) error {
+	// Have to add the master role to the owner role before we can alter the publication
+	roles, err := azpg.getPublicationOwnerRoles(ctx, dbname, publicationName, "")
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return azpg.runWithTemporaryRoleGrants(ctx, azpg.GetRoleForLogin(azpg.user), roles, func() error {
+		return azpg.pg.UpdatePublication(ctx, dbname, publicationName, builder)
+	})
+}
+
+func (azpg *azurepg) ChangePublicationOwner(ctx context.Context, dbname, publicationName, owner string) error {
+	// Have to add the master role to both owner roles before we can transfer the publication owner
+	roles, err := azpg.getPublicationOwnerRoles(ctx, dbname, publicationName, owner)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	return azpg.runWithTemporaryRoleGrants(ctx, azpg.GetRoleForLogin(azpg.user), roles, func() error {
+		return azpg.pg.ChangePublicationOwner(ctx, dbname, publicationName, owner)
+	})
+}
+
+func (azpg *azurepg) GetPublicationSequences(ctx context.Context, db, publicationName string) ([]*SequenceValue, error) {
+	// Have to add the master role to the sequence owner roles before we can read them
+	roles, err := azpg.getPublicationSequenceOwners(ctx, db, publicationName)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*SequenceValue
+
+	err = azpg.runWithTemporaryRoleGrants(ctx, azpg.GetRoleForLogin(azpg.user), roles, func() error {
+		var err2 error
+		res, err2 = azpg.pg.GetPublicationSequences(ctx, db, publicationName)
+
+		return err2
+	})
+
+	return res, err
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// Signaling table follows Debezium expected structure.
+	CreateCDCSignalingTableSQLTemplate = `CREATE TABLE IF NOT EXISTS "%s"."%s" (id varchar(42) PRIMARY KEY, type varchar(32) NOT NULL, data varchar(2048) NULL)` //nolint:lll//Because
+	CreateCDCHeartbeatTableSQLTemplate = `CREATE TABLE IF NOT EXISTS "%s"."%s" (id integer PRIMARY KEY, ts timestamptz NOT NULL DEFAULT now())`
+	GetTableReplicaIdentitySQLTemplate = `SELECT c.relreplident, COALESCE((SELECT i.relname FROM pg_index x
+JOIN pg_class i ON i.oid = x.indexrelid
+WHERE x.indrelid = c.oid AND x.indisreplident), '')
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = '%s' AND c.relname = '%s' AND c.relkind IN ('r', 'p')`
+	SetTableReplicaIdentitySQLTemplate                    = `ALTER TABLE "%s"."%s" REPLICA IDENTITY %s`
+	GetPublicationTablesWithoutReplicaIdentitySQLTemplate = `SELECT pt.schemaname, pt.tablename FROM pg_publication_tables pt
+JOIN pg_namespace n ON n.nspname = pt.schemaname
+JOIN pg_class c ON c.relnamespace = n.oid AND c.relname = pt.tablename
+WHERE pt.pubname = '%s' AND (c.relreplident = 'n'
+OR (c.relreplident = 'd' AND NOT EXISTS (SELECT 1 FROM pg_index x WHERE x.indrelid = c.oid AND x.indisprimary))
+OR (c.relreplident = 'i' AND NOT EXISTS (SELECT 1 FROM pg_index x WHERE x.indrelid = c.oid AND x.indisreplident)))
+ORDER BY pt.schemaname, pt.tablename`
+	DefaultReplicaIdentity          = "DEFAULT"
+	FullReplicaIdentity             = "FULL"
+	NothingReplicaIdentity          = "NOTHING"
+	UsingIndexReplicaIdentityPrefix = "USING INDEX "
+)
+
+// CreateCDCSignalingTable will create the CDC signaling table if it doesn't exist.
+// Table is created with the given role in order to be owned by it.
+func (c *pg) CreateCDCSignalingTable(ctx context.Context, db, schema, table, role string) error {
+	return c.createTableWithRole(ctx, db, role, fmt.Sprintf(CreateCDCSignalingTableSQLTemplate, schema, table))
+}
+
+// CreateCDCHeartbeatTable will create the CDC heartbeat table if it doesn't exist.
+// Table is created with the given role in order to be owned by it.
+func (c *pg) CreateCDCHeartbeatTable(ctx context.Context, db, schema, table, role string) error {
+	return c.createTableWithRole(ctx, db, role, fmt.Sprintf(CreateCDCHeartbeatTableSQLTemplate, schema, table))
+}
+
+func (c *pg) createTableWithRole(ctx context.Context, db, role, sqlStr string) (err error) {
+	err = c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+
+			err = errors.Join(err, err2)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(SetLocalRoleSQLTemplate, role))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, sqlStr)
+	if err != nil {
+		return err
+	}
+
+	// Commit
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTableReplicaIdentity will return table replica identity ("DEFAULT", "FULL", "NOTHING" or "USING INDEX <name>").
+// Empty string is returned when table isn't found.
+func (c *pg) GetTableReplicaIdentity(ctx context.Context, db, schema, table string) (string, error) {
+	err := c.connect(db)
+	if err != nil {
+		return "", err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetTableReplicaIdentitySQLTemplate, schema, table))
+	if err != nil {
+		return "", err
+	}
+
+	defer rows.Close()
+
+	res := ""
+
+	for rows.Next() {
+		it, index := "", ""
+		// Scan
+		err = rows.Scan(&it, &index)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+		// Save
+		res = parseReplicaIdentity(it, index)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
+
+func parseReplicaIdentity(relreplident, index string) string {
+	switch relreplident {
+	case "f":
+		return FullReplicaIdentity
+	case "n":
+		return NothingReplicaIdentity
+	case "i":
+		return UsingIndexReplicaIdentityPrefix + index
+	default:
+		return DefaultReplicaIdentity
+	}
+}
+
+// buildReplicaIdentitySQL will quote index name in "USING INDEX <name>" case.
+func buildReplicaIdentitySQL(identity string) string {
+	// Check if it is an index
+	if strings.HasPrefix(identity, UsingIndexReplicaIdentityPrefix) {
+		return UsingIndexReplicaIdentityPrefix + pq.QuoteIdentifier(strings.TrimPrefix(identity, UsingIndexReplicaIdentityPrefix))
+	}
+
+	return identity
+}
+
+func (c *pg) SetTableReplicaIdentity(ctx context.Context, db, schema, table, identity string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(SetTableReplicaIdentitySQLTemplate, schema, table, buildReplicaIdentitySQL(identity)))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPublicationTablesWithoutReplicaIdentity will return published tables ("schema.table")
+// without any usable replica identity for UPDATE and DELETE replication.
+func (c *pg) GetPublicationTablesWithoutReplicaIdentity(ctx context.Context, db, publicationName string) ([]string, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPublicationTablesWithoutReplicaIdentitySQLTemplate, publicationName))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []string{}
+
+	for rows.Next() {
+		schema, table := "", ""
+		// Scan
+		err = rows.Scan(&schema, &table)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, fmt.Sprintf("%s.%s", schema, table))
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
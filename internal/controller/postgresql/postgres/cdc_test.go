@@ -0,0 +1,44 @@
+package postgres
+
+import "testing"
+
+func TestParseReplicaIdentity(t *testing.T) {
+	tests := []struct {
+		relreplident string
+		index        string
+		want         string
+	}{
+		{relreplident: "d", want: DefaultReplicaIdentity},
+		{relreplident: "f", want: FullReplicaIdentity},
+		{relreplident: "n", want: NothingReplicaIdentity},
+		{relreplident: "i", index: "idx1", want: "USING INDEX idx1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.relreplident, func(t *testing.T) {
+			if got := parseReplicaIdentity(tt.relreplident, tt.index); got != tt.want {
+				t.Errorf("parseReplicaIdentity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildReplicaIdentitySQL(t *testing.T) {
+	tests := []struct {
+		identity string
+		want     string
+	}{
+		{identity: DefaultReplicaIdentity, want: "DEFAULT"},
+		{identity: FullReplicaIdentity, want: "FULL"},
+		{identity: NothingReplicaIdentity, want: "NOTHING"},
+		{identity: "USING INDEX idx1", want: `USING INDEX "idx1"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identity, func(t *testing.T) {
+			if got := buildReplicaIdentitySQL(tt.identity); got != tt.want {
+				t.Errorf("buildReplicaIdentitySQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
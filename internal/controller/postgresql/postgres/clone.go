@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	CreateDBFromTemplateSQLTemplate      = `CREATE DATABASE "%s" WITH OWNER = "%s" TEMPLATE = "%s"`
+	TerminateDatabaseSessionsSQLTemplate = `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = '%s' AND pid <> pg_backend_pid()`
+	GetUserSchemaListSQLTemplate         = `SELECT nspname FROM pg_namespace WHERE nspname NOT LIKE 'pg\_%' AND nspname <> 'information_schema'`
+	// Objects that are members of an extension are ignored as they are managed by the extension itself.
+	// System schemas (pg_* and information_schema) and their objects are always ignored.
+	GetOwnedObjectsSQLTemplate = `SELECT 'SCHEMA', quote_ident(n.nspname)
+FROM pg_namespace n
+WHERE n.nspowner = (SELECT oid FROM pg_roles WHERE rolname = '%[1]s')
+AND n.nspname NOT LIKE 'pg\_%%' AND n.nspname <> 'information_schema'
+UNION ALL
+SELECT CASE c.relkind
+    WHEN 'v' THEN 'VIEW'
+    WHEN 'm' THEN 'MATERIALIZED VIEW'
+    WHEN 'S' THEN 'SEQUENCE'
+    WHEN 'f' THEN 'FOREIGN TABLE'
+    ELSE 'TABLE' END,
+  quote_ident(n.nspname) || '.' || quote_ident(c.relname)
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relowner = (SELECT oid FROM pg_roles WHERE rolname = '%[1]s')
+AND c.relkind IN ('r', 'p', 'f', 'v', 'm', 'S')
+AND n.nspname NOT LIKE 'pg\_%%' AND n.nspname <> 'information_schema'
+AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.classid = 'pg_class'::regclass AND d.objid = c.oid AND d.deptype = 'e')
+UNION ALL
+SELECT 'ROUTINE', p.oid::regprocedure::text
+FROM pg_proc p
+JOIN pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proowner = (SELECT oid FROM pg_roles WHERE rolname = '%[1]s')
+AND n.nspname NOT LIKE 'pg\_%%' AND n.nspname <> 'information_schema'
+AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.classid = 'pg_proc'::regclass AND d.objid = p.oid AND d.deptype = 'e')
+UNION ALL
+SELECT CASE t.typtype WHEN 'd' THEN 'DOMAIN' ELSE 'TYPE' END, t.oid::regtype::text
+FROM pg_type t
+JOIN pg_namespace n ON n.oid = t.typnamespace
+WHERE t.typowner = (SELECT oid FROM pg_roles WHERE rolname = '%[1]s')
+AND t.typtype IN ('d', 'e', 'r')
+AND n.nspname NOT LIKE 'pg\_%%' AND n.nspname <> 'information_schema'
+AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.classid = 'pg_type'::regclass AND d.objid = t.oid AND d.deptype = 'e')`
+	ChangeObjectOwnerSQLTemplate  = `ALTER %s %s OWNER TO "%s"`
+	RevokeAllOnSchemaSQLTemplate  = `REVOKE ALL ON SCHEMA "%s" FROM "%s"`
+	RevokeAllTablesSQLTemplate    = `REVOKE ALL ON ALL TABLES IN SCHEMA "%s" FROM "%s"`
+	RevokeAllSequencesSQLTemplate = `REVOKE ALL ON ALL SEQUENCES IN SCHEMA "%s" FROM "%s"`
+	RevokeAllFunctionsSQLTemplate = `REVOKE ALL ON ALL FUNCTIONS IN SCHEMA "%s" FROM "%s"`
+	sequenceObjectType            = "SEQUENCE"
+)
+
+func (c *pg) CreateDBFromTemplate(ctx context.Context, dbname, role, template string) error {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CreateDBFromTemplateSQLTemplate, dbname, role, template))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) TerminateDatabaseSessions(ctx context.Context, database string) error {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(TerminateDatabaseSessionsSQLTemplate, database))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) ListUserSchemas(ctx context.Context, database string) ([]string, error) {
+	err := c.connect(database)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, GetUserSchemaListSQLTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []string{}
+
+	for rows.Next() {
+		it := ""
+		// Scan
+		err = rows.Scan(&it)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) ChangeOwnedObjectsOwner(ctx context.Context, database, oldOwner, newOwner string) error {
+	err := c.connect(database)
+	if err != nil {
+		return err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetOwnedObjectsSQLTemplate, oldOwner))
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	statements := []string{}
+	sequenceStatements := []string{}
+
+	for rows.Next() {
+		objType, objName := "", ""
+		// Scan
+		err = rows.Scan(&objType, &objName)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		stmt := fmt.Sprintf(ChangeObjectOwnerSQLTemplate, objType, objName, newOwner)
+		// Sequences are changed after tables because sequences owned by a table column
+		// are changed with the table
+		if objType == sequenceObjectType {
+			sequenceStatements = append(sequenceStatements, stmt)
+		} else {
+			statements = append(statements, stmt)
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Loop over statements
+	for _, stmt := range append(statements, sequenceStatements...) {
+		_, err = c.db.ExecContext(ctx, stmt)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *pg) RevokeAllPrivilegesInSchema(ctx context.Context, database, schema, role string) error {
+	err := c.connect(database)
+	if err != nil {
+		return err
+	}
+
+	// Loop over templates
+	for _, tpl := range []string{
+		RevokeAllOnSchemaSQLTemplate,
+		RevokeAllTablesSQLTemplate,
+		RevokeAllSequencesSQLTemplate,
+		RevokeAllFunctionsSQLTemplate,
+	} {
+		_, err = c.db.ExecContext(ctx, fmt.Sprintf(tpl, schema, role))
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
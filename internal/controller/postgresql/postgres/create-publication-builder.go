@@ -6,13 +6,15 @@ import (
 )
 
 type CreatePublicationBuilder struct {
-	name       string
-	tablesPart string
-	allTables  string
-	withPart   string
-	owner      string
-	tables     []string
-	schemaList []string
+	name             string
+	tablesPart       string
+	allTables        string
+	withPart         string
+	owner            string
+	tables           []string
+	schemaList       []string
+	schemaTables     []string
+	serverVersionNum int
 }
 
 func NewCreatePublicationBuilder() *CreatePublicationBuilder {
@@ -26,23 +28,34 @@ func (b *CreatePublicationBuilder) Build() {
 		return
 	}
 
+	// Compute tables and schema list depending on server version
+	tables, schemaList := computePublicationTablesAndSchemaList(b.serverVersionNum, b.tables, b.schemaList, b.schemaTables)
+
+	// Check if there isn't anything to publish
+	// ? Note: An empty publication is valid and tables can be added later
+	if len(tables) == 0 && len(schemaList) == 0 {
+		b.tablesPart = ""
+
+		return
+	}
+
 	// Build
 	res := "FOR "
 
 	// Check if tables are set
-	if len(b.tables) != 0 {
-		res += "TABLE " + strings.Join(b.tables, ", ")
+	if len(tables) != 0 {
+		res += "TABLE " + strings.Join(tables, ", ")
 	}
 
 	// Check if schema are set
-	if len(b.schemaList) != 0 {
+	if len(schemaList) != 0 {
 		// Check if tables were added
-		if len(b.tables) != 0 {
+		if len(tables) != 0 {
 			// Append
 			res += ", "
 		}
 
-		res += "TABLES IN SCHEMA " + strings.Join(b.schemaList, ", ")
+		res += "TABLES IN SCHEMA " + strings.Join(schemaList, ", ")
 	}
 
 	// Save
@@ -74,6 +87,18 @@ func (b *CreatePublicationBuilder) SetTablesInSchema(schemaList []string) *Creat
 	return b
 }
 
+func (b *CreatePublicationBuilder) SetServerVersionNum(version int) *CreatePublicationBuilder {
+	b.serverVersionNum = version
+
+	return b
+}
+
+func (b *CreatePublicationBuilder) SetTablesInSchemaTables(tables []string) *CreatePublicationBuilder {
+	b.schemaTables = tables
+
+	return b
+}
+
 func (b *CreatePublicationBuilder) SetForAllTables() *CreatePublicationBuilder {
 	b.allTables = "FOR ALL TABLES"
 
@@ -0,0 +1,138 @@
+package postgres
+
+import "testing"
+
+func TestCreatePublicationBuilderBuild(t *testing.T) {
+	tests := []struct {
+		name             string
+		serverVersionNum int
+		tables           []string
+		schemaList       []string
+		schemaTables     []string
+		want             string
+	}{
+		{
+			name: "nothing to publish",
+			want: "",
+		},
+		{
+			name:             "tables in schema",
+			serverVersionNum: 150000,
+			tables:           []string{"table1"},
+			schemaList:       []string{"schema1"},
+			schemaTables:     []string{`"schema1"."table2"`},
+			want:             "FOR TABLE table1, TABLES IN SCHEMA schema1",
+		},
+		{
+			name:         "unknown version",
+			schemaList:   []string{"schema1"},
+			schemaTables: []string{`"schema1"."table2"`},
+			want:         "FOR TABLES IN SCHEMA schema1",
+		},
+		{
+			name:             "tables in schema emulated",
+			serverVersionNum: 140000,
+			tables:           []string{"table1"},
+			schemaList:       []string{"schema1"},
+			schemaTables:     []string{`"schema1"."table2"`, `"schema1"."table3"`},
+			want:             `FOR TABLE table1, "schema1"."table2", "schema1"."table3"`,
+		},
+		{
+			name:             "tables in schema emulated with empty schema",
+			serverVersionNum: 130000,
+			schemaList:       []string{"schema1"},
+			want:             "",
+		},
+		{
+			name:             "tables in schema emulated on PostgreSQL 12",
+			serverVersionNum: 120000,
+			tables:           []string{"table1"},
+			schemaList:       []string{"schema1"},
+			schemaTables:     []string{`"schema1"."table2"`},
+			want:             `FOR TABLE table1, "schema1"."table2"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewCreatePublicationBuilder().
+				SetServerVersionNum(tt.serverVersionNum).
+				SetTablesInSchema(tt.schemaList).
+				SetTablesInSchemaTables(tt.schemaTables)
+
+			for _, it := range tt.tables {
+				b = b.AddTable(it, nil, nil)
+			}
+
+			b.Build()
+
+			if b.tablesPart != tt.want {
+				t.Errorf("Build() tablesPart = %v, want %v", b.tablesPart, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdatePublicationBuilderBuild(t *testing.T) {
+	tests := []struct {
+		name             string
+		serverVersionNum int
+		tables           []string
+		schemaList       []string
+		schemaTables     []string
+		dropTables       []string
+		wantTables       string
+		wantDropTables   string
+	}{
+		{
+			name:             "tables in schema",
+			serverVersionNum: 160000,
+			schemaList:       []string{"schema1"},
+			schemaTables:     []string{`"schema1"."table1"`},
+			dropTables:       []string{`"schema1"."table1"`},
+			wantTables:       "TABLES IN SCHEMA schema1",
+		},
+		{
+			name:             "tables in schema emulated",
+			serverVersionNum: 120000,
+			tables:           []string{"table1"},
+			schemaList:       []string{"schema1"},
+			schemaTables:     []string{`"schema1"."table2"`},
+			wantTables:       `TABLE table1, "schema1"."table2"`,
+		},
+		{
+			name:             "tables in schema emulated with empty schema",
+			serverVersionNum: 140000,
+			schemaList:       []string{"schema1"},
+			dropTables:       []string{`"schema1"."table1"`},
+			wantDropTables:   `TABLE "schema1"."table1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewUpdatePublicationBuilder().
+				SetServerVersionNum(tt.serverVersionNum).
+				SetTablesInSchema(tt.schemaList).
+				SetTablesInSchemaTables(tt.schemaTables)
+
+			for _, it := range tt.tables {
+				b = b.AddSetTable(it, nil, nil)
+			}
+
+			for _, it := range tt.dropTables {
+				b = b.AddDropTable(it)
+			}
+
+			b.Build()
+
+			if b.tablesPart != tt.wantTables {
+				t.Errorf("Build() tablesPart = %v, want %v", b.tablesPart, tt.wantTables)
+			}
+
+			if b.dropTablesPart != tt.wantDropTables {
+				t.Errorf("Build() dropTablesPart = %v, want %v", b.dropTablesPart, tt.wantDropTables)
+			}
+		})
+	}
+}
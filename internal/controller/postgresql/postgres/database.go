@@ -432,13 +432,13 @@ func (c *pg) ListExtensions(ctx context.Context, database string) ([]string, err
 	return res, nil
 }
 
-func (c *pg) CreateExtension(ctx context.Context, db, extension string) error {
+func (c *pg) CreateExtension(ctx context.Context, db, extension, schema, version string, cascade bool) error {
 	err := c.connect(db)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CreateExtensionSQLTemplate, extension))
+	_, err = c.db.ExecContext(ctx, buildCreateExtensionSQL(extension, schema, version, cascade))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+const (
+	GetDatabaseSessionsSQLTemplate = `SELECT pid, COALESCE(usename, ''), COALESCE(application_name, ''), COALESCE(client_addr::text, '')
+FROM pg_stat_activity WHERE datname = '%s' AND pid <> pg_backend_pid() ORDER BY pid`
+	DropDatabaseWithForceSQLTemplate = `DROP DATABASE "%s" WITH (FORCE)`
+	GetServerVersionNumSQLTemplate   = `SHOW server_version_num`
+	// WITH (FORCE) option is available since PostgreSQL 13.
+	dropDatabaseWithForceMinVersion = 130000
+)
+
+type DatabaseSession struct {
+	Pid             int
+	Username        string
+	ApplicationName string
+	ClientAddr      string
+}
+
+func (c *pg) GetServerVersionNum(ctx context.Context) (int, error) {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return 0, err
+	}
+
+	var res string
+	// Get version
+	err = c.db.QueryRowContext(ctx, GetServerVersionNumSQLTemplate).Scan(&res)
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(res)
+}
+
+func (c *pg) GetDatabaseSessions(ctx context.Context, database string) ([]*DatabaseSession, error) {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetDatabaseSessionsSQLTemplate, database))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []*DatabaseSession{}
+
+	for rows.Next() {
+		it := &DatabaseSession{}
+		// Scan
+		err = rows.Scan(&it.Pid, &it.Username, &it.ApplicationName, &it.ClientAddr)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) ForceDropDatabase(ctx context.Context, database string) error {
+	// Get version to know if WITH (FORCE) is supported
+	version, err := c.GetServerVersionNum(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Revoke connect to avoid new sessions
+	err = c.revokeDatabaseConnect(ctx, database)
+	if err != nil {
+		return err
+	}
+
+	// Terminate remaining sessions
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(TerminateDatabaseSessionsSQLTemplate, database))
+	if err != nil {
+		return err
+	}
+
+	// Check if force option can be used
+	// ? Note: Sessions opened between terminate and drop are closed by PostgreSQL with this option
+	if version < dropDatabaseWithForceMinVersion {
+		return c.DropDatabase(ctx, database)
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(DropDatabaseWithForceSQLTemplate, database))
+	if err != nil {
+		return err
+	}
+
+	c.log.Info(fmt.Sprintf("Dropped database %s with force", database))
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const (
+	CreateExtensionSchemaOption   = ` SCHEMA "%s"`
+	CreateExtensionVersionOption  = ` VERSION %s`
+	UpdateExtensionSQLTemplate    = `ALTER EXTENSION "%s" UPDATE TO %s`
+	SetExtensionSchemaSQLTemplate = `ALTER EXTENSION "%s" SET SCHEMA "%s"`
+	GetExtensionSQLTemplate       = `SELECT e.extversion, n.nspname, e.extrelocatable FROM pg_extension e
+JOIN pg_namespace n ON n.oid = e.extnamespace WHERE e.extname = '%s'`
+	GetAvailableExtensionSQLTemplate         = `SELECT default_version FROM pg_available_extensions WHERE name = '%s'`
+	GetAvailableExtensionVersionsSQLTemplate = `SELECT version FROM pg_available_extension_versions WHERE name = '%s' ORDER BY version`
+	HasExtensionUpdatePathSQLTemplate        = `SELECT EXISTS (SELECT 1 FROM pg_extension_update_paths('%s') WHERE source = '%s' AND target = '%s' AND path IS NOT NULL)` //nolint:lll//Because
+)
+
+type Extension struct {
+	Name        string
+	Version     string
+	Schema      string
+	Relocatable bool
+}
+
+type AvailableExtension struct {
+	Name           string
+	DefaultVersion string
+	Versions       []string
+}
+
+// buildCreateExtensionSQL will build extension creation statement with optional schema, version and cascade.
+func buildCreateExtensionSQL(extension, schema, version string, cascade bool) string {
+	sql := fmt.Sprintf(CreateExtensionSQLTemplate, extension)
+
+	// Check schema
+	if schema != "" {
+		sql += fmt.Sprintf(CreateExtensionSchemaOption, schema)
+	}
+	// Check version
+	if version != "" {
+		sql += fmt.Sprintf(CreateExtensionVersionOption, pq.QuoteLiteral(version))
+	}
+	// Check cascade
+	if cascade {
+		sql += " " + CascadeKeyword
+	}
+
+	return sql
+}
+
+func (c *pg) GetExtension(ctx context.Context, db, extension string) (*Extension, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetExtensionSQLTemplate, extension))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := &Extension{Name: extension}
+
+	var foundOne bool
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&res.Version, &res.Schema, &res.Relocatable)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Update marker
+		foundOne = true
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if found marker isn't set
+	if !foundOne {
+		return nil, nil
+	}
+
+	return res, nil
+}
+
+func (c *pg) GetAvailableExtension(ctx context.Context, db, extension string) (*AvailableExtension, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AvailableExtension{Name: extension, Versions: []string{}}
+
+	var foundOne bool
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetAvailableExtensionSQLTemplate, extension))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&res.DefaultVersion)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Update marker
+		foundOne = true
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if found marker isn't set
+	if !foundOne {
+		return nil, nil
+	}
+
+	versionRows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetAvailableExtensionVersionsSQLTemplate, extension))
+	if err != nil {
+		return nil, err
+	}
+
+	defer versionRows.Close()
+
+	for versionRows.Next() {
+		it := ""
+		// Scan
+		err = versionRows.Scan(&it)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res.Versions = append(res.Versions, it)
+	}
+
+	// Rows error
+	err = versionRows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) HasExtensionUpdatePath(ctx context.Context, db, extension, source, target string) (bool, error) {
+	err := c.connect(db)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(HasExtensionUpdatePathSQLTemplate, extension, source, target))
+	if err != nil {
+		return false, err
+	}
+
+	defer rows.Close()
+
+	res := false
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&res)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) UpdateExtension(ctx context.Context, db, extension, version string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(UpdateExtensionSQLTemplate, extension, pq.QuoteLiteral(version)))
+	if err != nil {
+		return err
+	}
+
+	c.log.Info(fmt.Sprintf("Updated extension %s to version %s on database %s", extension, version, db))
+
+	return nil
+}
+
+func (c *pg) SetExtensionSchema(ctx context.Context, db, extension, schema string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(SetExtensionSchemaSQLTemplate, extension, schema))
+	if err != nil {
+		return err
+	}
+
+	c.log.Info(fmt.Sprintf("Moved extension %s to schema %s on database %s", extension, schema, db))
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import "testing"
+
+func TestBuildCreateExtensionSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		extension string
+		schema    string
+		version   string
+		cascade   bool
+		want      string
+	}{
+		{
+			name:      "default options",
+			extension: "uuid-ossp",
+			want:      `CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`,
+		},
+		{
+			name:      "all options",
+			extension: "postgis",
+			schema:    "gis",
+			version:   "3.4.0",
+			cascade:   true,
+			want:      `CREATE EXTENSION IF NOT EXISTS "postgis" SCHEMA "gis" VERSION '3.4.0' CASCADE`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildCreateExtensionSQL(tt.extension, tt.schema, tt.version, tt.cascade); got != tt.want {
+				t.Errorf("buildCreateExtensionSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	GetRoleTableGrantsSQLTemplate  = `SELECT table_schema, table_name, privilege_type FROM information_schema.role_table_grants WHERE grantee = '%s' AND privilege_type IN ('SELECT', 'INSERT', 'UPDATE', 'DELETE')`     //nolint:lll//Because
+	GetRoleColumnGrantsSQLTemplate = `SELECT table_schema, table_name, column_name, privilege_type FROM information_schema.role_column_grants WHERE grantee = '%s' AND privilege_type IN ('SELECT', 'INSERT', 'UPDATE')` //nolint:lll//Because
+	GrantTableSQLTemplate          = `GRANT %s ON TABLE "%s"."%s" TO "%s"`
+	RevokeTableSQLTemplate         = `REVOKE %s ON TABLE "%s"."%s" FROM "%s"`
+	GrantColumnsSQLTemplate        = `GRANT %s (%s) ON TABLE "%s"."%s" TO "%s"`
+	RevokeColumnsSQLTemplate       = `REVOKE %s (%s) ON TABLE "%s"."%s" FROM "%s"`
+)
+
+type TableGrant struct {
+	Schema    string
+	Table     string
+	Privilege string
+}
+
+type ColumnGrant struct {
+	Schema    string
+	Table     string
+	Column    string
+	Privilege string
+}
+
+func (c *pg) GetRoleTableGrants(ctx context.Context, db, role string) ([]*TableGrant, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetRoleTableGrantsSQLTemplate, role))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]*TableGrant, 0)
+
+	for rows.Next() {
+		it := &TableGrant{}
+		// Scan
+		err = rows.Scan(&it.Schema, &it.Table, &it.Privilege)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetRoleColumnGrants will return column grants for role.
+// Note: information_schema.role_column_grants also lists table level grants for each column.
+func (c *pg) GetRoleColumnGrants(ctx context.Context, db, role string) ([]*ColumnGrant, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetRoleColumnGrantsSQLTemplate, role))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]*ColumnGrant, 0)
+
+	for rows.Next() {
+		it := &ColumnGrant{}
+		// Scan
+		err = rows.Scan(&it.Schema, &it.Table, &it.Column, &it.Privilege)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) GrantOnTable(ctx context.Context, db, schema, table, privilege, role string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(GrantTableSQLTemplate, privilege, schema, table, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) RevokeOnTable(ctx context.Context, db, schema, table, privilege, role string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(RevokeTableSQLTemplate, privilege, schema, table, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) GrantOnColumns(ctx context.Context, db, schema, table, privilege string, columns []string, role string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(GrantColumnsSQLTemplate, privilege, buildColumnsString(columns), schema, table, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) RevokeOnColumns(ctx context.Context, db, schema, table, privilege string, columns []string, role string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(RevokeColumnsSQLTemplate, privilege, buildColumnsString(columns), schema, table, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) GrantUsageOnSchema(ctx context.Context, db, schema, role string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(GrantUsageSchemaSQLTemplate, schema, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func buildColumnsString(columns []string) string {
+	res := make([]string, 0, len(columns))
+	// Quote all columns
+	for _, col := range columns {
+		res = append(res, fmt.Sprintf(`"%s"`, col))
+	}
+
+	return strings.Join(res, ", ")
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	CreateMigrationTrackingTableSQLTemplate = `CREATE TABLE IF NOT EXISTS "%s"."%s" (version text PRIMARY KEY, checksum text NOT NULL, applied_at timestamptz NOT NULL DEFAULT now())` //nolint:lll//Because
+	GetAppliedMigrationsSQLTemplate         = `SELECT version, checksum, applied_at FROM "%s"."%s" ORDER BY version`
+	InsertAppliedMigrationSQLTemplate       = `INSERT INTO "%s"."%s" (version, checksum) VALUES ($1, $2)`
+	SetLocalRoleSQLTemplate                 = `SET LOCAL ROLE "%s"`
+)
+
+type AppliedMigration struct {
+	AppliedAt time.Time
+	Version   string
+	Checksum  string
+}
+
+// CreateMigrationTrackingTable will create the tracking table if it doesn't exist.
+// Table is created with the given role in order to be owned by it.
+func (c *pg) CreateMigrationTrackingTable(ctx context.Context, db, schema, table, role string) error {
+	return c.createTableWithRole(ctx, db, role, fmt.Sprintf(CreateMigrationTrackingTableSQLTemplate, schema, table))
+}
+
+func (c *pg) GetAppliedMigrations(ctx context.Context, db, schema, table string) ([]*AppliedMigration, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetAppliedMigrationsSQLTemplate, schema, table))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := make([]*AppliedMigration, 0)
+
+	for rows.Next() {
+		it := &AppliedMigration{}
+		// Scan
+		err = rows.Scan(&it.Version, &it.Checksum, &it.AppliedAt)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ApplyMigration will run migration content in a transaction with the given role
+// and save it in the tracking table in the same transaction.
+// Role is set to have objects owned by it but this isn't a security boundary as content can reset it:
+// connection must be done with a login role member of the given one and not with an admin one.
+func (c *pg) ApplyMigration(ctx context.Context, db, schema, table, role, version, checksum, content string) (err error) {
+	err = c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+
+			err = errors.Join(err, err2)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(SetLocalRoleSQLTemplate, role))
+	if err != nil {
+		return err
+	}
+
+	// ? Note: Content can contain multiple statements as it is sent without any argument
+	_, err = tx.ExecContext(ctx, content)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(InsertAppliedMigrationSQLTemplate, schema, table), version, checksum)
+	if err != nil {
+		return err
+	}
+
+	// Commit
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
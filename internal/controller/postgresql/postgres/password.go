@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5" //nolint:gosec // Needed for PostgreSQL md5 password encryption
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"unicode"
+)
+
+const (
+	GetPasswordEncryptionSQLTemplate = `SHOW password_encryption`
+	MD5PasswordEncryption            = "md5"
+	// PostgreSQL < 10 was using on/off values and on means md5.
+	OnPasswordEncryption = "on"
+	// Same default values as PostgreSQL.
+	SCRAMSHA256DefaultIterations = 4096
+	SCRAMSHA256SaltLength        = 16
+	scramSHA256Prefix            = "SCRAM-SHA-256"
+	scramClientKeyMessage        = "Client Key"
+	scramServerKeyMessage        = "Server Key"
+	md5Prefix                    = "md5"
+)
+
+func (c *pg) GetPasswordEncryption(ctx context.Context) (string, error) {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return "", err
+	}
+
+	rows, err := c.db.QueryContext(ctx, GetPasswordEncryptionSQLTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	defer rows.Close()
+
+	res := ""
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&res)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
+
+// encryptPassword will return the verifier to send to PostgreSQL instead of the cleartext password.
+// SCRAM-SHA-256 is used except when engine is configured to use md5.
+func (c *pg) encryptPassword(ctx context.Context, role, password string) (string, error) {
+	// Get password encryption
+	passwordEncryption, err := c.GetPasswordEncryption(ctx)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return buildPasswordVerifier(passwordEncryption, role, password)
+}
+
+func buildPasswordVerifier(passwordEncryption, role, password string) (string, error) {
+	// Check if md5 is required
+	if passwordEncryption == MD5PasswordEncryption || passwordEncryption == OnPasswordEncryption {
+		return BuildMD5PasswordVerifier(role, password), nil
+	}
+
+	// Check if password isn't pure ASCII
+	// ? Note: PostgreSQL applies SASLprep on non ASCII passwords before computing SCRAM-SHA-256 verifier.
+	// This isn't implemented here, so password is sent in clear and PostgreSQL will encrypt it.
+	if !isASCII(password) {
+		return password, nil
+	}
+
+	// Generate salt
+	salt := make([]byte, SCRAMSHA256SaltLength)
+
+	_, err := rand.Read(salt)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	return BuildSCRAMSHA256PasswordVerifier(password, salt, SCRAMSHA256DefaultIterations), nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BuildMD5PasswordVerifier will build a md5 password verifier as stored by PostgreSQL.
+// Format is "md5" followed by md5(password + role) in hexadecimal.
+func BuildMD5PasswordVerifier(role, password string) string {
+	sum := md5.Sum([]byte(password + role)) //nolint:gosec // Needed for PostgreSQL md5 password encryption
+
+	return md5Prefix + hex.EncodeToString(sum[:])
+}
+
+// BuildSCRAMSHA256PasswordVerifier will build a SCRAM-SHA-256 password verifier as stored by PostgreSQL.
+// Format is "SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>" with base64 encoded values.
+// Note: PostgreSQL applies SASLprep on password, so this must only be used with ASCII passwords
+// for which SASLprep doesn't change anything.
+func BuildSCRAMSHA256PasswordVerifier(password string, salt []byte, iterations int) string {
+	saltedPassword := pbkdf2SHA256([]byte(password), salt, iterations)
+
+	clientKey := hmacSHA256(saltedPassword, []byte(scramClientKeyMessage))
+	storedKey := sha256.Sum256(clientKey)
+	serverKey := hmacSHA256(saltedPassword, []byte(scramServerKeyMessage))
+
+	return fmt.Sprintf(
+		"%s$%d:%s$%s:%s",
+		scramSHA256Prefix,
+		iterations,
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(storedKey[:]),
+		base64.StdEncoding.EncodeToString(serverKey),
+	)
+}
+
+func hmacSHA256(key, message []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+
+	return mac.Sum(nil)
+}
+
+// pbkdf2SHA256 is the PBKDF2 function (RFC 2898) with HMAC-SHA-256 limited to one block
+// as SCRAM-SHA-256 only needs a key with the same length as the hash.
+func pbkdf2SHA256(password, salt []byte, iterations int) []byte {
+	prf := hmac.New(sha256.New, password)
+
+	// First iteration is using salt with block index
+	blockIndex := make([]byte, 4) //nolint:gomnd // INT(i) is 4 bytes long
+	binary.BigEndian.PutUint32(blockIndex, 1)
+
+	prf.Write(salt)
+	prf.Write(blockIndex)
+	u := prf.Sum(nil)
+
+	res := make([]byte, len(u))
+	copy(res, u)
+
+	for i := 1; i < iterations; i++ {
+		prf.Reset()
+		prf.Write(u)
+		u = prf.Sum(u[:0])
+
+		for j := range res {
+			res[j] ^= u[j]
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestBuildSCRAMSHA256PasswordVerifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		password   string
+		salt       string
+		iterations int
+		want       string
+	}{
+		{
+			// Values from RFC 7677 example
+			name:       "rfc 7677 example",
+			password:   "pencil",
+			salt:       "W22ZaJ0SNY7soEsUEjb6gQ==",
+			iterations: 4096,
+			want:       "SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		},
+		{
+			name:       "ascii salt",
+			password:   "postgres",
+			salt:       "MDEyMzQ1Njc4OWFiY2RlZg==",
+			iterations: 4096,
+			want:       "SCRAM-SHA-256$4096:MDEyMzQ1Njc4OWFiY2RlZg==$w2c4qgE2XBwQ/nt0/3lD0jEJCDvVvzRZPQmJb69QsOM=:dWTP8e4i+OF5r8lgAXo6jD/R/r508B/dZo4o/G1IMrk=",
+		},
+		{
+			name:       "one iteration",
+			password:   "password",
+			salt:       "AAAAAAAAAAAAAAAAAAAAAA==",
+			iterations: 1,
+			want:       "SCRAM-SHA-256$1:AAAAAAAAAAAAAAAAAAAAAA==$YJGPFHYOrTFSteAuTf5aLdNOh1tIRz5+x/9td52FN/w=:W3mgpU6DUfQmHlh6y4EtAVBFnOnHqQBk2goX6LGZL2s=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			salt, err := base64.StdEncoding.DecodeString(tt.salt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := BuildSCRAMSHA256PasswordVerifier(tt.password, salt, tt.iterations)
+			if got != tt.want {
+				t.Errorf("BuildSCRAMSHA256PasswordVerifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildSCRAMSHA256PasswordVerifierFormat(t *testing.T) {
+	// Format expected by PostgreSQL to detect a pre-encrypted password
+	re := regexp.MustCompile(`^SCRAM-SHA-256\$4096:[A-Za-z0-9+/]{22}==\$[A-Za-z0-9+/]{43}=:[A-Za-z0-9+/]{43}=$`)
+
+	got := BuildSCRAMSHA256PasswordVerifier("fake-password", make([]byte, SCRAMSHA256SaltLength), SCRAMSHA256DefaultIterations)
+	if !re.MatchString(got) {
+		t.Errorf("BuildSCRAMSHA256PasswordVerifier() = %v, doesn't match PostgreSQL format", got)
+	}
+}
+
+func TestBuildMD5PasswordVerifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		password string
+		want     string
+	}{
+		{
+			name:     "postgres user",
+			role:     "postgres",
+			password: "password",
+			want:     "md532e12f215ba27cb750c9e093ce4b5127",
+		},
+		{
+			name:     "simple user",
+			role:     "user",
+			password: "foo",
+			want:     "md5c7a2cbc1e78ea0af6d8354361c3b0f8a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildMD5PasswordVerifier(tt.role, tt.password)
+			if got != tt.want {
+				t.Errorf("BuildMD5PasswordVerifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPasswordVerifier(t *testing.T) {
+	tests := []struct {
+		name               string
+		passwordEncryption string
+		password           string
+		wantPrefix         string
+		wantCleartext      bool
+	}{
+		{
+			name:               "ascii password with scram",
+			passwordEncryption: "scram-sha-256",
+			password:           "password",
+			wantPrefix:         "SCRAM-SHA-256$",
+		},
+		{
+			name:               "non ascii password with scram",
+			passwordEncryption: "scram-sha-256",
+			password:           "pässwörd ｆｏｏ",
+			wantCleartext:      true,
+		},
+		{
+			name:               "non ascii password with md5",
+			passwordEncryption: MD5PasswordEncryption,
+			password:           "pässwörd",
+			wantPrefix:         "md5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPasswordVerifier(tt.passwordEncryption, "user", tt.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantCleartext {
+				if got != tt.password {
+					t.Errorf("buildPasswordVerifier() = %v, want cleartext password", got)
+				}
+
+				return
+			}
+
+			if !strings.HasPrefix(got, tt.wantPrefix) {
+				t.Errorf("buildPasswordVerifier() = %v, want prefix %v", got, tt.wantPrefix)
+			}
+		})
+	}
+}
@@ -32,8 +32,21 @@ type PG interface { //nolint:interfacebloat // This is needed
 	ChangeDBOwner(ctx context.Context, dbname, owner string) error
 	IsDatabaseExist(ctx context.Context, dbname string) (bool, error)
 	RenameDatabase(ctx context.Context, oldname, newname string) error
+	CreateDBFromTemplate(ctx context.Context, dbname, role, template string) error
+	TerminateDatabaseSessions(ctx context.Context, database string) error
+	ListUserSchemas(ctx context.Context, database string) ([]string, error)
+	ChangeOwnedObjectsOwner(ctx context.Context, database, oldOwner, newOwner string) error
+	RevokeAllPrivilegesInSchema(ctx context.Context, database, schema, role string) error
+	TombstoneDatabase(ctx context.Context, tombstone *DatabaseTombstone) error
+	ListDatabaseTombstones(ctx context.Context) ([]*DatabaseTombstone, error)
+	RestoreDatabaseTombstone(ctx context.Context, tombstone *DatabaseTombstone) error
 	CreateSchema(ctx context.Context, db, role, schema string) error
-	CreateExtension(ctx context.Context, db, extension string) error
+	CreateExtension(ctx context.Context, db, extension, schema, version string, cascade bool) error
+	GetExtension(ctx context.Context, db, extension string) (*Extension, error)
+	GetAvailableExtension(ctx context.Context, db, extension string) (*AvailableExtension, error)
+	HasExtensionUpdatePath(ctx context.Context, db, extension, source, target string) (bool, error)
+	UpdateExtension(ctx context.Context, db, extension, version string) error
+	SetExtensionSchema(ctx context.Context, db, extension, schema string) error
 	CreateGroupRole(ctx context.Context, role string) error
 	CreateUserRole(ctx context.Context, role, password string, attributes *RoleAttributes) (string, error)
 	AlterRoleAttributes(ctx context.Context, role string, attributes *RoleAttributes) error
@@ -41,6 +54,7 @@ type PG interface { //nolint:interfacebloat // This is needed
 	IsRoleExist(ctx context.Context, role string) (bool, error)
 	RenameRole(ctx context.Context, oldname, newname string) error
 	UpdatePassword(ctx context.Context, role, password string) error
+	GetPasswordEncryption(ctx context.Context) (string, error)
 	GrantRole(ctx context.Context, role, grantee string, withAdminOption bool) error
 	SetSchemaPrivileges(ctx context.Context, db, creator, role, schema, privs string) error
 	RevokeRole(ctx context.Context, role, userRole string) error
@@ -48,7 +62,12 @@ type PG interface { //nolint:interfacebloat // This is needed
 	AlterDefaultLoginRoleOnDatabase(ctx context.Context, role, setRole, database string) error
 	RevokeUserSetRoleOnDatabase(ctx context.Context, role, database string) error
 	DoesRoleHaveActiveSession(ctx context.Context, role string) (bool, error)
+	GetRoleActiveSessions(ctx context.Context, role string) (*RoleActiveSessions, error)
+	TerminateRoleSessions(ctx context.Context, role string) error
 	DropDatabase(ctx context.Context, db string) error
+	ForceDropDatabase(ctx context.Context, database string) error
+	GetDatabaseSessions(ctx context.Context, database string) ([]*DatabaseSession, error)
+	GetServerVersionNum(ctx context.Context) (int, error)
 	DropRoleAndDropAndChangeOwnedBy(ctx context.Context, role, newOwner, database string) error
 	ChangeAndDropOwnedBy(ctx context.Context, role, newOwner, database string) error
 	GetSetRoleOnDatabasesRoleSettings(ctx context.Context, role string) ([]*SetRoleOnDatabaseRoleSetting, error)
@@ -69,10 +88,38 @@ type PG interface { //nolint:interfacebloat // This is needed
 	UpdatePublication(ctx context.Context, dbname, publicationName string, builder *UpdatePublicationBuilder) error
 	ChangePublicationOwner(ctx context.Context, dbname string, publicationName string, owner string) error
 	GetPublicationTablesDetails(ctx context.Context, db, publicationName string) ([]*PublicationTableDetail, error)
+	ListTablesWithComment(ctx context.Context, db string) ([]*TableWithComment, error)
+	GetPublicationSequences(ctx context.Context, db, publicationName string) ([]*SequenceValue, error)
+	SetSequencesValue(ctx context.Context, db string, values []*SequenceValue) error
 	DropReplicationSlot(ctx context.Context, name string) error
-	CreateReplicationSlot(ctx context.Context, dbname, name, plugin string) error
+	CreateReplicationSlot(ctx context.Context, dbname, name, plugin string, options *ReplicationSlotOptions) error
 	GetReplicationSlot(ctx context.Context, name string) (*ReplicationSlotResult, error)
+	GetReplicationSlotStats(ctx context.Context, name string) (*ReplicationSlotStats, error)
+	TerminateReplicationSlotConsumer(ctx context.Context, name string) error
 	GetColumnNamesFromTable(ctx context.Context, database string, schemaName string, tableName string) ([]string, error)
+	GetRoleTableGrants(ctx context.Context, db, role string) ([]*TableGrant, error)
+	GetRoleColumnGrants(ctx context.Context, db, role string) ([]*ColumnGrant, error)
+	GrantOnTable(ctx context.Context, db, schema, table, privilege, role string) error
+	RevokeOnTable(ctx context.Context, db, schema, table, privilege, role string) error
+	GrantOnColumns(ctx context.Context, db, schema, table, privilege string, columns []string, role string) error
+	RevokeOnColumns(ctx context.Context, db, schema, table, privilege string, columns []string, role string) error
+	GrantUsageOnSchema(ctx context.Context, db, schema, role string) error
+	GetTableRowLevelSecurity(ctx context.Context, db, schema, table string) (*TableRowLevelSecurity, error)
+	EnableRowLevelSecurity(ctx context.Context, db, schema, table string) error
+	ForceRowLevelSecurity(ctx context.Context, db, schema, table string, force bool) error
+	GetPolicy(ctx context.Context, db, schema, table, name string) (*Policy, error)
+	CreatePolicy(ctx context.Context, db string, policy *Policy) error
+	AlterPolicy(ctx context.Context, db string, policy *Policy) error
+	RenamePolicy(ctx context.Context, db, schema, table, oldname, newname string) error
+	DropPolicy(ctx context.Context, db, schema, table, name string) error
+	CreateMigrationTrackingTable(ctx context.Context, db, schema, table, role string) error
+	GetAppliedMigrations(ctx context.Context, db, schema, table string) ([]*AppliedMigration, error)
+	ApplyMigration(ctx context.Context, db, schema, table, role, version, checksum, content string) error
+	CreateCDCSignalingTable(ctx context.Context, db, schema, table, role string) error
+	CreateCDCHeartbeatTable(ctx context.Context, db, schema, table, role string) error
+	GetTableReplicaIdentity(ctx context.Context, db, schema, table string) (string, error)
+	SetTableReplicaIdentity(ctx context.Context, db, schema, table, identity string) error
+	GetPublicationTablesWithoutReplicaIdentity(ctx context.Context, db, publicationName string) ([]string, error)
 	GetUser() string
 	GetHost() string
 	GetPort() int
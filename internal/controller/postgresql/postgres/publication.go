@@ -14,14 +14,43 @@ const (
 	AlterPublicationRenameSQLTemplate           = `ALTER PUBLICATION "%s" RENAME TO "%s"`
 	AlterPublicationChangeOwnerSQLTemplate      = `ALTER PUBLICATION "%s" OWNER TO "%s"`
 	AlterPublicationGeneralOperationSQLTemplate = `ALTER PUBLICATION "%s" SET %s`
+	AlterPublicationDropSQLTemplate             = `ALTER PUBLICATION "%s" DROP %s`
 	GetPublicationSQLTemplate                   = `SELECT
   pg_catalog.pg_get_userbyid(pubowner), puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot
 FROM pg_catalog.pg_publication
 WHERE pubname = '%s';`
-	GetPublicationTablesSQLTemplate  = `SELECT schemaname, tablename, attnames, rowfilter FROM pg_publication_tables WHERE pubname = '%s'`
-	GetReplicationSlotSQLTemplate    = `SELECT slot_name,plugin,database FROM pg_replication_slots WHERE slot_name = '%s'`
-	CreateReplicationSlotSQLTemplate = `SELECT pg_create_logical_replication_slot('%s', '%s')`
-	DropReplicationSlotSQLTemplate   = `SELECT pg_drop_replication_slot('%s')`
+	// Before PostgreSQL 13, publish via partition root doesn't exist.
+	GetPublicationLegacySQLTemplate = `SELECT
+  pg_catalog.pg_get_userbyid(pubowner), puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname = '%s';`
+	// publish_via_partition_root have been added in PostgreSQL 13.
+	PublicationViaRootMinVersion    = 130000
+	GetPublicationTablesSQLTemplate = `SELECT schemaname, tablename, attnames, rowfilter FROM pg_publication_tables WHERE pubname = '%s'`
+	GetReplicationSlotSQLTemplate   = `SELECT slot_name, COALESCE(plugin, ''), COALESCE(database, ''), slot_type, temporary
+FROM pg_replication_slots WHERE slot_name = '%s'`
+	CreateReplicationSlotSQLTemplate         = `SELECT pg_create_logical_replication_slot('%s', '%s'%s)`
+	CreatePhysicalReplicationSlotSQLTemplate = `SELECT pg_create_physical_replication_slot('%s'%s)`
+	DropReplicationSlotSQLTemplate           = `SELECT pg_drop_replication_slot('%s')`
+	PhysicalReplicationSlotType              = "physical"
+	LogicalReplicationSlotType               = "logical"
+	// Before PostgreSQL 15, all columns are published and there isn't any row filter.
+	GetPublicationTablesLegacySQLTemplate = `SELECT pt.schemaname, pt.tablename,
+  ARRAY(SELECT a.attname FROM pg_attribute a WHERE a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped ORDER BY a.attnum)::text[],
+  NULL::text
+FROM pg_publication_tables pt
+JOIN pg_namespace n ON n.nspname = pt.schemaname
+JOIN pg_class c ON c.relnamespace = n.oid AND c.relname = pt.tablename
+WHERE pt.pubname = '%s'`
+	// TABLES IN SCHEMA, column lists and row filters have been added in PostgreSQL 15.
+	PublicationTablesInSchemaMinVersion = 150000
+	// Partitions are excluded as they are published through their root.
+	ListTablesWithCommentSQLTemplate = `SELECT n.nspname, c.relname, COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition
+AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_%'
+ORDER BY n.nspname, c.relname`
 )
 
 type PublicationResult struct {
@@ -41,19 +70,51 @@ type PublicationTableDetail struct {
 	Columns         []string
 }
 
+type TableWithComment struct {
+	SchemaName string
+	TableName  string
+	Comment    string
+}
+
 type ReplicationSlotResult struct {
-	SlotName string
-	Plugin   string
-	Database string
+	SlotName  string
+	Plugin    string
+	Database  string
+	SlotType  string
+	Temporary bool
+}
+
+type ReplicationSlotOptions struct {
+	// Create a physical replication slot instead of a logical one
+	Physical bool
+	// Reserve WAL immediately (physical only)
+	ImmediatelyReserve bool
+	// Enable decoding of prepared transactions (logical only, PostgreSQL 14+)
+	TwoPhase bool
+	// Synchronize replication slot to standbys (logical only, PostgreSQL 17+)
+	Failover bool
 }
 
 func (c *pg) GetPublicationTablesDetails(ctx context.Context, db, publicationName string) ([]*PublicationTableDetail, error) {
-	err := c.connect(db)
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute query
+	sqlTemplate := GetPublicationTablesSQLTemplate
+	if version < PublicationTablesInSchemaMinVersion {
+		sqlTemplate = GetPublicationTablesLegacySQLTemplate
+	}
+
+	err = c.connect(db)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPublicationTablesSQLTemplate, publicationName))
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(sqlTemplate, publicationName))
 	if err != nil {
 		return nil, err
 	}
@@ -105,13 +166,48 @@ func (c *pg) DropReplicationSlot(ctx context.Context, name string) error {
 	return nil
 }
 
-func (c *pg) CreateReplicationSlot(ctx context.Context, dbname, name, plugin string) error {
+// buildCreateReplicationSlotSQL will build replication slot creation statement.
+// Optional arguments use named notation to avoid passing unsupported ones on older servers.
+func buildCreateReplicationSlotSQL(name, plugin string, options *ReplicationSlotOptions) string {
+	// Check if there isn't any option
+	if options == nil {
+		return fmt.Sprintf(CreateReplicationSlotSQLTemplate, name, plugin, "")
+	}
+
+	args := ""
+
+	// Check physical case
+	if options.Physical {
+		if options.ImmediatelyReserve {
+			args += ", immediately_reserve => true"
+		}
+
+		return fmt.Sprintf(CreatePhysicalReplicationSlotSQLTemplate, name, args)
+	}
+
+	if options.TwoPhase {
+		args += ", twophase => true"
+	}
+
+	if options.Failover {
+		args += ", failover => true"
+	}
+
+	return fmt.Sprintf(CreateReplicationSlotSQLTemplate, name, plugin, args)
+}
+
+func (c *pg) CreateReplicationSlot(ctx context.Context, dbname, name, plugin string, options *ReplicationSlotOptions) error {
+	// Physical replication slots aren't linked to a database
+	if dbname == "" {
+		dbname = c.defaultDatabase
+	}
+
 	err := c.connect(dbname)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CreateReplicationSlotSQLTemplate, name, plugin))
+	_, err = c.db.ExecContext(ctx, buildCreateReplicationSlotSQL(name, plugin, options))
 	if err != nil {
 		return err
 	}
@@ -140,7 +236,7 @@ func (c *pg) GetReplicationSlot(ctx context.Context, name string) (*ReplicationS
 
 	for rows.Next() {
 		// Scan
-		err = rows.Scan(&res.SlotName, &res.Plugin, &res.Database)
+		err = rows.Scan(&res.SlotName, &res.Plugin, &res.Database, &res.SlotType, &res.Temporary)
 		// Check error
 		if err != nil {
 			return nil, err
@@ -166,6 +262,29 @@ func (c *pg) GetReplicationSlot(ctx context.Context, name string) (*ReplicationS
 }
 
 func (c *pg) UpdatePublication(ctx context.Context, dbname, publicationName string, builder *UpdatePublicationBuilder) (err error) {
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save version
+	builder.SetServerVersionNum(version)
+
+	// Check if tables in schema must be emulated
+	if version < PublicationTablesInSchemaMinVersion && len(builder.schemaList) != 0 {
+		// List tables
+		tables, err2 := c.listTablesInSchemaList(ctx, dbname, builder.schemaList)
+		// Check error
+		if err2 != nil {
+			return err2
+		}
+
+		// Save
+		builder.SetTablesInSchemaTables(tables)
+	}
+
 	// Connect to db
 	err = c.connect(dbname)
 	if err != nil {
@@ -196,6 +315,14 @@ func (c *pg) UpdatePublication(ctx context.Context, dbname, publicationName stri
 		}
 	}
 
+	// Manage dropped tables
+	if builder.dropTablesPart != "" {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(AlterPublicationDropSQLTemplate, publicationName, builder.dropTablesPart))
+		if err != nil {
+			return err
+		}
+	}
+
 	// Manage tables
 	if builder.tablesPart != "" {
 		_, err = tx.ExecContext(ctx, fmt.Sprintf(AlterPublicationGeneralOperationSQLTemplate, publicationName, builder.tablesPart))
@@ -242,8 +369,31 @@ func (c *pg) ChangePublicationOwner(ctx context.Context, dbname string, publicat
 }
 
 func (c *pg) CreatePublication(ctx context.Context, dbname string, builder *CreatePublicationBuilder) error {
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save version
+	builder.SetServerVersionNum(version)
+
+	// Check if tables in schema must be emulated
+	if version < PublicationTablesInSchemaMinVersion && len(builder.schemaList) != 0 {
+		// List tables
+		tables, err2 := c.listTablesInSchemaList(ctx, dbname, builder.schemaList)
+		// Check error
+		if err2 != nil {
+			return err2
+		}
+
+		// Save
+		builder.SetTablesInSchemaTables(tables)
+	}
+
 	// Connect to db
-	err := c.connect(dbname)
+	err = c.connect(dbname)
 	if err != nil {
 		return err
 	}
@@ -256,6 +406,11 @@ func (c *pg) CreatePublication(ctx context.Context, dbname string, builder *Crea
 		return err
 	}
 
+	// Check if owner must be changed
+	if builder.owner == "" {
+		return nil
+	}
+
 	// Change owner
 	err = c.ChangePublicationOwner(ctx, dbname, builder.name, builder.owner)
 	if err != nil {
@@ -267,13 +422,20 @@ func (c *pg) CreatePublication(ctx context.Context, dbname string, builder *Crea
 }
 
 func (c *pg) GetPublication(ctx context.Context, dbname, name string) (*PublicationResult, error) {
-	err := c.connect(dbname)
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.connect(dbname)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get rows
-	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPublicationSQLTemplate, name))
+	rows, err := c.db.QueryContext(ctx, buildGetPublicationSQLQuery(version, name))
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +473,15 @@ func (c *pg) GetPublication(ctx context.Context, dbname, name string) (*Publicat
 	return &res, nil
 }
 
+func buildGetPublicationSQLQuery(version int, name string) string {
+	// Check if publish via partition root is supported
+	if version < PublicationViaRootMinVersion {
+		return fmt.Sprintf(GetPublicationLegacySQLTemplate, name)
+	}
+
+	return fmt.Sprintf(GetPublicationSQLTemplate, name)
+}
+
 func (c *pg) DropPublication(ctx context.Context, dbname, name string) error {
 	err := c.connect(dbname)
 	if err != nil {
@@ -343,3 +514,156 @@ func (c *pg) RenamePublication(ctx context.Context, dbname, oldname, newname str
 
 	return nil
 }
+
+func (c *pg) ListTablesWithComment(ctx context.Context, db string) ([]*TableWithComment, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, ListTablesWithCommentSQLTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []*TableWithComment{}
+
+	for rows.Next() {
+		it := &TableWithComment{}
+		// Scan
+		err = rows.Scan(&it.SchemaName, &it.TableName, &it.Comment)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// listTablesInSchemaList will return quoted names of all tables in schema list.
+// This is used to emulate TABLES IN SCHEMA before PostgreSQL 15.
+func (c *pg) listTablesInSchemaList(ctx context.Context, dbname string, schemaList []string) ([]string, error) {
+	res := []string{}
+
+	// Loop over schema
+	for _, sch := range schemaList {
+		// Get tables
+		tables, err := c.GetTablesInSchema(ctx, dbname, sch)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Save
+		for _, it := range tables {
+			res = append(res, fmt.Sprintf("\"%s\".\"%s\"", sch, it.TableName))
+		}
+	}
+
+	return res, nil
+}
+
+// computePublicationTablesAndSchemaList will return tables and schema list to use in publication statements.
+// Before PostgreSQL 15, schema list is replaced by its tables. An unknown version (0) is considered as supporting TABLES IN SCHEMA.
+func computePublicationTablesAndSchemaList(serverVersionNum int, tables, schemaList, schemaTables []string) ([]string, []string) {
+	// Check if tables in schema is supported
+	if serverVersionNum == 0 || serverVersionNum >= PublicationTablesInSchemaMinVersion {
+		return tables, schemaList
+	}
+
+	// Merge tables
+	res := append([]string{}, tables...)
+	res = append(res, schemaTables...)
+
+	return res, nil
+}
+
+// getPublicationOwnerRoles will return current and new owner roles of a publication.
+func (c *pg) getPublicationOwnerRoles(ctx context.Context, dbname, publicationName, newOwner string) ([]string, error) {
+	res := []string{newOwner}
+
+	// Get publication
+	pubRes, err := c.GetPublication(ctx, dbname, publicationName)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if publication exists
+	if pubRes != nil {
+		res = append(res, pubRes.Owner)
+	}
+
+	return res, nil
+}
+
+// runWithTemporaryRoleGrants will grant roles to member, run function and revoke granted roles.
+// Roles already granted to member are ignored and kept.
+// This is used on cloud providers where admin user isn't a real superuser and needs to be a member of roles to manage their objects.
+func (c *pg) runWithTemporaryRoleGrants(ctx context.Context, member string, roles []string, fn func() error) error {
+	// Get current membership
+	membership, err := c.GetRoleMembership(ctx, member)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save roles to ignore
+	ignored := map[string]bool{"": true, member: true}
+	for _, role := range membership {
+		ignored[role] = true
+	}
+
+	granted := []string{}
+
+	defer func() {
+		// Loop over granted roles
+		for _, role := range granted {
+			err := c.RevokeRole(ctx, role, member)
+			// Check error
+			if err != nil {
+				c.log.Error(err, "error in revoke role")
+			}
+		}
+	}()
+
+	// Loop over roles
+	for _, role := range roles {
+		// Ignore empty, member, already member and already granted roles
+		if ignored[role] {
+			continue
+		}
+
+		// Save as ignored for next ones
+		ignored[role] = true
+
+		err = c.GrantRole(ctx, role, member, false)
+		// Check error
+		if err != nil {
+			// Try to cast error
+			pqErr, ok := err.(*pq.Error)
+			if !ok || pqErr.Code != InvalidGrantOperationErrorCode {
+				return err
+			}
+
+			continue
+		}
+
+		// Save
+		granted = append(granted, role)
+	}
+
+	return fn()
+}
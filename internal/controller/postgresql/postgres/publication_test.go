@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildGetPublicationSQLQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int
+		contains string
+	}{
+		{
+			name:     "postgresql 12",
+			version:  120000,
+			contains: "false AS pubviaroot",
+		},
+		{
+			name:     "postgresql 13",
+			version:  130000,
+			contains: "pubtruncate, pubviaroot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildGetPublicationSQLQuery(tt.version, "pub1")
+			if !strings.Contains(got, tt.contains) {
+				t.Errorf("buildGetPublicationSQLQuery() = %v, want to contain %v", got, tt.contains)
+			}
+			if !strings.Contains(got, "pubname = 'pub1'") {
+				t.Errorf("buildGetPublicationSQLQuery() = %v, want publication name", got)
+			}
+		})
+	}
+}
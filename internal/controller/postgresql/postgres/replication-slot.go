@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// Lag and retained WAL are computed from current WAL position.
+	GetReplicationSlotStatsSQLTemplate = `SELECT
+  active,
+  COALESCE(restart_lsn::text, ''),
+  COALESCE(confirmed_flush_lsn::text, ''),
+  COALESCE(pg_wal_lsn_diff(pg_current_wal_lsn(), restart_lsn), 0)::bigint,
+  COALESCE(pg_wal_lsn_diff(pg_current_wal_lsn(), confirmed_flush_lsn), 0)::bigint,
+  %s
+FROM pg_replication_slots
+WHERE slot_name = '%s'`
+	TerminateReplicationSlotConsumerSQLTemplate = `SELECT pg_terminate_backend(active_pid) FROM pg_replication_slots
+WHERE slot_name = '%s' AND active_pid IS NOT NULL`
+	// Timeout version waits for consumer to exit (or timeout to be reached).
+	TerminateReplicationSlotConsumerWithTimeoutSQLTemplate = `SELECT pg_terminate_backend(active_pid, %d) FROM pg_replication_slots
+WHERE slot_name = '%s' AND active_pid IS NOT NULL`
+	replicationSlotWALStatusColumn = `COALESCE(wal_status, '')`
+	// wal_status column have been added in PostgreSQL 13.
+	replicationSlotWALStatusMinVersion = 130000
+	// pg_terminate_backend timeout have been added in PostgreSQL 14.
+	terminateBackendTimeoutMinVersion = 140000
+	// Timeout in milliseconds to wait for a replication slot consumer to exit.
+	replicationSlotConsumerTerminateTimeout = 5000
+)
+
+type ReplicationSlotStats struct {
+	RestartLSN             string
+	ConfirmedFlushLSN      string
+	WALStatus              string
+	RetainedWALBytes       int64
+	ConfirmedFlushLagBytes int64
+	Active                 bool
+}
+
+func (c *pg) GetReplicationSlotStats(ctx context.Context, name string) (*ReplicationSlotStats, error) {
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute wal status column
+	walStatusColumn := "''"
+	if version >= replicationSlotWALStatusMinVersion {
+		walStatusColumn = replicationSlotWALStatusColumn
+	}
+
+	err = c.connect(c.defaultDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get rows
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetReplicationSlotStatsSQLTemplate, walStatusColumn, name))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res ReplicationSlotStats
+
+	var foundOne bool
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&res.Active, &res.RestartLSN, &res.ConfirmedFlushLSN, &res.RetainedWALBytes, &res.ConfirmedFlushLagBytes, &res.WALStatus)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Update marker
+		foundOne = true
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if found marker isn't set
+	if !foundOne {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// TerminateReplicationSlotConsumer will terminate replication slot consumer.
+// On PostgreSQL 14 and later, it waits for consumer to exit. Before, consumer can still be active when this returns.
+func (c *pg) TerminateReplicationSlotConsumer(ctx context.Context, name string) error {
+	// Get server version
+	version, err := c.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Build query
+	sqlQuery := fmt.Sprintf(TerminateReplicationSlotConsumerSQLTemplate, name)
+	if version >= terminateBackendTimeoutMinVersion {
+		sqlQuery = fmt.Sprintf(TerminateReplicationSlotConsumerWithTimeoutSQLTemplate, replicationSlotConsumerTerminateTimeout, name)
+	}
+
+	err = c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, sqlQuery)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import "testing"
+
+func TestBuildCreateReplicationSlotSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		slot    string
+		plugin  string
+		options *ReplicationSlotOptions
+		want    string
+	}{
+		{
+			name:   "logical without options",
+			slot:   "slot1",
+			plugin: "pgoutput",
+			want:   `SELECT pg_create_logical_replication_slot('slot1', 'pgoutput')`,
+		},
+		{
+			name:    "logical with two phase and failover",
+			slot:    "slot1",
+			plugin:  "pgoutput",
+			options: &ReplicationSlotOptions{TwoPhase: true, Failover: true},
+			want:    `SELECT pg_create_logical_replication_slot('slot1', 'pgoutput', twophase => true, failover => true)`,
+		},
+		{
+			name:    "physical",
+			slot:    "slot1",
+			options: &ReplicationSlotOptions{Physical: true},
+			want:    `SELECT pg_create_physical_replication_slot('slot1')`,
+		},
+		{
+			name:    "physical with immediately reserve",
+			slot:    "slot1",
+			options: &ReplicationSlotOptions{Physical: true, ImmediatelyReserve: true},
+			want:    `SELECT pg_create_physical_replication_slot('slot1', immediately_reserve => true)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildCreateReplicationSlotSQL(tt.slot, tt.plugin, tt.options); got != tt.want {
+				t.Errorf("buildCreateReplicationSlotSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const (
+	GetTableRowLevelSecuritySQLTemplate = `SELECT c.relrowsecurity, c.relforcerowsecurity
+FROM pg_catalog.pg_class c
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = '%s' AND c.relname = '%s'`
+	EnableRowLevelSecuritySQLTemplate  = `ALTER TABLE "%s"."%s" ENABLE ROW LEVEL SECURITY`
+	ForceRowLevelSecuritySQLTemplate   = `ALTER TABLE "%s"."%s" FORCE ROW LEVEL SECURITY`
+	NoForceRowLevelSecuritySQLTemplate = `ALTER TABLE "%s"."%s" NO FORCE ROW LEVEL SECURITY`
+	GetPolicySQLTemplate               = `SELECT permissive, roles, cmd, qual, with_check FROM pg_catalog.pg_policies WHERE schemaname = '%s' AND tablename = '%s' AND policyname = '%s'` //nolint:lll//Because
+	CreatePolicySQLTemplate            = `CREATE POLICY "%s" ON "%s"."%s" AS %s FOR %s TO %s%s`
+	AlterPolicySQLTemplate             = `ALTER POLICY "%s" ON "%s"."%s" TO %s%s`
+	RenamePolicySQLTemplate            = `ALTER POLICY "%s" ON "%s"."%s" RENAME TO "%s"`
+	DropPolicySQLTemplate              = `DROP POLICY IF EXISTS "%s" ON "%s"."%s"`
+	PermissivePolicy                   = "PERMISSIVE"
+	RestrictivePolicy                  = "RESTRICTIVE"
+	PublicPolicyRole                   = "public"
+	UndefinedTableErrorCode            = "42P01"
+)
+
+type TableRowLevelSecurity struct {
+	Enabled bool
+	Forced  bool
+}
+
+type Policy struct {
+	Using     *string
+	WithCheck *string
+	Name      string
+	Schema    string
+	Table     string
+	Command   string
+	Roles     []string
+	// Permissive or restrictive
+	Permissive bool
+}
+
+func (c *pg) GetTableRowLevelSecurity(ctx context.Context, db, schema, table string) (*TableRowLevelSecurity, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetTableRowLevelSecuritySQLTemplate, schema, table))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res *TableRowLevelSecurity
+
+	for rows.Next() {
+		res = &TableRowLevelSecurity{}
+		// Scan
+		err = rows.Scan(&res.Enabled, &res.Forced)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) EnableRowLevelSecurity(ctx context.Context, db, schema, table string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(EnableRowLevelSecuritySQLTemplate, schema, table))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) ForceRowLevelSecurity(ctx context.Context, db, schema, table string, force bool) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	// Select template
+	tpl := NoForceRowLevelSecuritySQLTemplate
+	if force {
+		tpl = ForceRowLevelSecuritySQLTemplate
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(tpl, schema, table))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) GetPolicy(ctx context.Context, db, schema, table, name string) (*Policy, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPolicySQLTemplate, schema, table, name))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res *Policy
+
+	for rows.Next() {
+		var (
+			permissive       string
+			roles            pq.StringArray
+			using, withCheck sql.NullString
+		)
+
+		res = &Policy{Name: name, Schema: schema, Table: table}
+		// Scan
+		err = rows.Scan(&permissive, &roles, &res.Command, &using, &withCheck)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		// Save
+		res.Permissive = permissive == PermissivePolicy
+		res.Roles = roles
+
+		if using.Valid {
+			res.Using = &using.String
+		}
+
+		if withCheck.Valid {
+			res.WithCheck = &withCheck.String
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) CreatePolicy(ctx context.Context, db string, policy *Policy) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	// Compute policy type
+	policyType := RestrictivePolicy
+	if policy.Permissive {
+		policyType = PermissivePolicy
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(
+		CreatePolicySQLTemplate,
+		policy.Name,
+		policy.Schema,
+		policy.Table,
+		policyType,
+		policy.Command,
+		buildPolicyRolesString(policy.Roles),
+		buildPolicyExpressionsString(policy.Using, policy.WithCheck),
+	))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AlterPolicy will change roles and expressions of an existing policy.
+// Note: Command and permissive/restrictive type cannot be changed without recreating the policy.
+func (c *pg) AlterPolicy(ctx context.Context, db string, policy *Policy) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(
+		AlterPolicySQLTemplate,
+		policy.Name,
+		policy.Schema,
+		policy.Table,
+		buildPolicyRolesString(policy.Roles),
+		buildPolicyExpressionsString(policy.Using, policy.WithCheck),
+	))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) RenamePolicy(ctx context.Context, db, schema, table, oldname, newname string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(RenamePolicySQLTemplate, oldname, schema, table, newname))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *pg) DropPolicy(ctx context.Context, db, schema, table, name string) error {
+	err := c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(DropPolicySQLTemplate, name, schema, table))
+	if err != nil {
+		// Ignore undefined table as policy is removed with it
+		pqErr, ok := err.(*pq.Error)
+		if !ok || pqErr.Code != UndefinedTableErrorCode {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func buildPolicyRolesString(roles []string) string {
+	// No roles means public
+	if len(roles) == 0 {
+		return PublicPolicyRole
+	}
+
+	res := make([]string, 0, len(roles))
+	// Quote all roles
+	for _, role := range roles {
+		res = append(res, fmt.Sprintf(`"%s"`, role))
+	}
+
+	return strings.Join(res, ", ")
+}
+
+func buildPolicyExpressionsString(using, withCheck *string) string {
+	res := ""
+
+	if using != nil {
+		res += fmt.Sprintf(" USING (%s)", *using)
+	}
+
+	if withCheck != nil {
+		res += fmt.Sprintf(" WITH CHECK (%s)", *withCheck)
+	}
+
+	return res
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import "testing"
+
+func TestBuildPolicyRolesString(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []string
+		want  string
+	}{
+		{
+			name:  "no roles",
+			roles: nil,
+			want:  "public",
+		},
+		{
+			name:  "multiple roles",
+			roles: []string{"reader", "writer"},
+			want:  `"reader", "writer"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPolicyRolesString(tt.roles)
+			if got != tt.want {
+				t.Errorf("buildPolicyRolesString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPolicyExpressionsString(t *testing.T) {
+	using := "tenant_id = 1"
+	withCheck := "tenant_id = 2"
+
+	tests := []struct {
+		name      string
+		using     *string
+		withCheck *string
+		want      string
+	}{
+		{
+			name: "no expressions",
+			want: "",
+		},
+		{
+			name:  "using only",
+			using: &using,
+			want:  " USING (tenant_id = 1)",
+		},
+		{
+			name:      "with check only",
+			withCheck: &withCheck,
+			want:      " WITH CHECK (tenant_id = 2)",
+		},
+		{
+			name:      "both expressions",
+			using:     &using,
+			withCheck: &withCheck,
+			want:      " USING (tenant_id = 1) WITH CHECK (tenant_id = 2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPolicyExpressionsString(tt.using, tt.withCheck)
+			if got != tt.want {
+				t.Errorf("buildPolicyExpressionsString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
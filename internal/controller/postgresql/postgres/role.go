@@ -2,15 +2,17 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 )
 
 const (
 	CreateGroupRoleSQLTemplate             = `CREATE ROLE "%s"`
-	CreateUserRoleSQLTemplate              = `CREATE ROLE "%s" WITH LOGIN PASSWORD '%s' %s`
+	CreateUserRoleSQLTemplate              = `CREATE ROLE "%s" WITH PASSWORD '%s' %s`
 	GrantRoleSQLTemplate                   = `GRANT "%s" TO "%s"`
 	GrantRoleWithAdminOptionSQLTemplate    = `GRANT "%s" TO "%s" WITH ADMIN OPTION`
 	AlterUserSetRoleSQLTemplate            = `ALTER USER "%s" SET ROLE "%s"`
@@ -25,12 +27,14 @@ const (
 	RenameRoleSQLTemplate                  = `ALTER ROLE "%s" RENAME TO "%s"`
 	AlterRoleWithOptionSQLTemplate         = `ALTER ROLE "%s" WITH %s`
 	// Source: https://dba.stackexchange.com/questions/136858/postgresql-display-role-members
-	GetRoleMembershipSQLTemplate = `SELECT r1.rolname as "role" FROM pg_catalog.pg_roles r JOIN pg_catalog.pg_auth_members m ON (m.member = r.oid) JOIN pg_roles r1 ON (m.roleid=r1.oid) WHERE r.rolcanlogin AND r.rolname='%s'`
-	GetRoleAttributesSQLTemplate = `select rolconnlimit, rolreplication, rolbypassrls FROM pg_roles WHERE rolname = '%s'`
+	GetRoleMembershipSQLTemplate = `SELECT r1.rolname as "role" FROM pg_catalog.pg_roles r JOIN pg_catalog.pg_auth_members m ON (m.member = r.oid) JOIN pg_roles r1 ON (m.roleid=r1.oid) WHERE r.rolname='%s'`
+	GetRoleAttributesSQLTemplate = `select rolconnlimit, rolreplication, rolbypassrls, rolcreatedb, rolcreaterole, rolinherit, rolcanlogin, CASE WHEN isfinite(rolvaliduntil) THEN rolvaliduntil END FROM pg_roles WHERE rolname = '%s'`
 	// DO NOT TOUCH THIS
 	// Cannot filter on compute value so... cf line before.
-	GetRoleSettingsSQLTemplate           = `SELECT pg_catalog.split_part(pg_catalog.unnest(setconfig), '=', 1) as parameter_type, pg_catalog.split_part(pg_catalog.unnest(setconfig), '=', 2) as parameter_value, d.datname as database FROM pg_catalog.pg_roles r JOIN pg_catalog.pg_db_role_setting c ON (c.setrole = r.oid) JOIN pg_catalog.pg_database d ON (d.oid = c.setdatabase) WHERE r.rolcanlogin AND r.rolname='%s'` //nolint:lll//Because
+	GetRoleSettingsSQLTemplate           = `SELECT pg_catalog.split_part(pg_catalog.unnest(setconfig), '=', 1) as parameter_type, pg_catalog.split_part(pg_catalog.unnest(setconfig), '=', 2) as parameter_value, d.datname as database FROM pg_catalog.pg_roles r JOIN pg_catalog.pg_db_role_setting c ON (c.setrole = r.oid) JOIN pg_catalog.pg_database d ON (d.oid = c.setdatabase) WHERE r.rolname='%s'` //nolint:lll//Because
 	DoesRoleHaveActiveSessionSQLTemplate = `SELECT 1 from pg_stat_activity WHERE usename = '%s' group by usename`
+	GetRoleActiveSessionsSQLTemplate     = `SELECT count(*), min(backend_start) FROM pg_stat_activity WHERE usename = '%s'`
+	TerminateRoleSessionsSQLTemplate     = `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = '%s' AND pid <> pg_backend_pid()`
 	DuplicateRoleErrorCode               = "42710"
 	RoleNotFoundErrorCode                = "42704"
 	InvalidGrantOperationErrorCode       = "0LP01"
@@ -40,12 +44,29 @@ var (
 	DefaultAttributeConnectionLimit = -1
 	DefaultAttributeReplication     = false
 	DefaultAttributeBypassRLS       = false
+	DefaultAttributeCreateDB        = false
+	DefaultAttributeCreateRole      = false
+	DefaultAttributeInherit         = true
+	DefaultAttributeLogin           = true
+	// Zero time is used to represent 'infinity'.
+	DefaultAttributeValidUntil = time.Time{}
 )
 
 type RoleAttributes struct {
 	ConnectionLimit *int
 	Replication     *bool
 	BypassRLS       *bool
+	CreateDB        *bool
+	CreateRole      *bool
+	Inherit         *bool
+	Login           *bool
+	// Note: Zero time means 'infinity'
+	ValidUntil *time.Time
+}
+
+type RoleActiveSessions struct {
+	OldestSessionStart *time.Time
+	Count              int
 }
 
 func (*pg) buildAttributesString(attributes *RoleAttributes) string {
@@ -79,6 +100,51 @@ func (*pg) buildAttributesString(attributes *RoleAttributes) string {
 		}
 	}
 
+	// CreateDB case
+	if attributes.CreateDB != nil {
+		if *attributes.CreateDB {
+			res = append(res, "CREATEDB")
+		} else {
+			res = append(res, "NOCREATEDB")
+		}
+	}
+
+	// CreateRole case
+	if attributes.CreateRole != nil {
+		if *attributes.CreateRole {
+			res = append(res, "CREATEROLE")
+		} else {
+			res = append(res, "NOCREATEROLE")
+		}
+	}
+
+	// Inherit case
+	if attributes.Inherit != nil {
+		if *attributes.Inherit {
+			res = append(res, "INHERIT")
+		} else {
+			res = append(res, "NOINHERIT")
+		}
+	}
+
+	// Login case
+	if attributes.Login != nil {
+		if *attributes.Login {
+			res = append(res, "LOGIN")
+		} else {
+			res = append(res, "NOLOGIN")
+		}
+	}
+
+	// Valid until case
+	if attributes.ValidUntil != nil {
+		if attributes.ValidUntil.IsZero() {
+			res = append(res, "VALID UNTIL 'infinity'")
+		} else {
+			res = append(res, fmt.Sprintf("VALID UNTIL '%s'", attributes.ValidUntil.UTC().Format(time.RFC3339)))
+		}
+	}
+
 	return strings.Join(res, " ")
 }
 
@@ -108,6 +174,10 @@ func (c *pg) GetRoleAttributes(ctx context.Context, role string) (*RoleAttribute
 		ConnectionLimit: new(int),
 		Replication:     new(bool),
 		BypassRLS:       new(bool),
+		CreateDB:        new(bool),
+		CreateRole:      new(bool),
+		Inherit:         new(bool),
+		Login:           new(bool),
 	}
 
 	err := c.connect(c.defaultDatabase)
@@ -123,12 +193,27 @@ func (c *pg) GetRoleAttributes(ctx context.Context, role string) (*RoleAttribute
 	defer rows.Close()
 
 	for rows.Next() {
+		validUntil := sql.NullTime{}
 		// Scan
-		err = rows.Scan(res.ConnectionLimit, res.Replication, res.BypassRLS)
+		err = rows.Scan(
+			res.ConnectionLimit,
+			res.Replication,
+			res.BypassRLS,
+			res.CreateDB,
+			res.CreateRole,
+			res.Inherit,
+			res.Login,
+			&validUntil,
+		)
 		// Check error
 		if err != nil {
 			return res, err
 		}
+
+		// Save valid until only if it is defined and not infinity
+		if validUntil.Valid {
+			res.ValidUntil = &validUntil.Time
+		}
 	}
 
 	// Rows error
@@ -206,10 +291,27 @@ func (c *pg) CreateUserRole(ctx context.Context, role, password string, attribut
 		return "", err
 	}
 
+	// Copy attributes to ensure login is set
+	// Note: User roles are login roles by default
+	loginAttributes := &RoleAttributes{}
+	if attributes != nil {
+		*loginAttributes = *attributes
+	}
+
+	if loginAttributes.Login == nil {
+		loginAttributes.Login = &DefaultAttributeLogin
+	}
+
 	// Build attributes sql
-	attributesSQLStr := c.buildAttributesString(attributes)
+	attributesSQLStr := c.buildAttributesString(loginAttributes)
+
+	// Encrypt password to avoid sending it in clear
+	encryptedPassword, err := c.encryptPassword(ctx, role, password)
+	if err != nil {
+		return "", err
+	}
 
-	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CreateUserRoleSQLTemplate, role, password, attributesSQLStr))
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CreateUserRoleSQLTemplate, role, encryptedPassword, attributesSQLStr))
 	if err != nil {
 		return "", err
 	}
@@ -414,7 +516,13 @@ func (c *pg) UpdatePassword(ctx context.Context, role, password string) error {
 		return err
 	}
 
-	_, err = c.db.ExecContext(ctx, fmt.Sprintf(UpdatePasswordSQLTemplate, role, password))
+	// Encrypt password to avoid sending it in clear
+	encryptedPassword, err := c.encryptPassword(ctx, role, password)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(UpdatePasswordSQLTemplate, role, encryptedPassword))
 	if err != nil {
 		return err
 	}
@@ -473,3 +581,58 @@ func (c *pg) RenameRole(ctx context.Context, oldname, newname string) error {
 
 	return nil
 }
+
+func (c *pg) GetRoleActiveSessions(ctx context.Context, role string) (*RoleActiveSessions, error) {
+	res := &RoleActiveSessions{}
+
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return res, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetRoleActiveSessionsSQLTemplate, role))
+	if err != nil {
+		return res, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		// Oldest session start can be null when no session exists
+		oldest := sql.NullTime{}
+		// Scan
+		err = rows.Scan(&res.Count, &oldest)
+		// Check error
+		if err != nil {
+			return res, err
+		}
+
+		// Check if oldest session start is set
+		if oldest.Valid {
+			res.OldestSessionStart = &oldest.Time
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) TerminateRoleSessions(ctx context.Context, role string) error {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(TerminateRoleSessionsSQLTemplate, role))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
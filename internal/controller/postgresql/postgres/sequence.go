@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	// Sequences are found from published table columns (serial and identity ones).
+	// Last value is NULL for sequences never used or not readable by current user.
+	GetPublicationSequencesSQLTemplate = `SELECT DISTINCT sn.nspname, s.relname, has_sequence_privilege(s.oid, 'SELECT, USAGE'),
+  CASE WHEN has_sequence_privilege(s.oid, 'SELECT, USAGE') THEN pg_sequence_last_value(s.oid) END
+` + publicationSequencesFromSQL + `
+ORDER BY sn.nspname, s.relname`
+	GetPublicationSequenceOwnersSQLTemplate = `SELECT DISTINCT pg_catalog.pg_get_userbyid(s.relowner)
+` + publicationSequencesFromSQL
+	publicationSequencesFromSQL = `FROM pg_publication_tables pt
+JOIN pg_namespace tn ON tn.nspname = pt.schemaname
+JOIN pg_class t ON t.relnamespace = tn.oid AND t.relname = pt.tablename
+JOIN pg_depend d ON d.refobjid = t.oid AND d.refclassid = 'pg_class'::regclass AND d.classid = 'pg_class'::regclass AND d.deptype IN ('a', 'i')
+JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+JOIN pg_namespace sn ON sn.oid = s.relnamespace
+WHERE pt.pubname = '%s'`
+	SetSequenceValueSQLTemplate = `SELECT setval('"%s"."%s"', %d, true)`
+)
+
+type SequenceValue struct {
+	LastValue    *int64
+	SchemaName   string
+	SequenceName string
+}
+
+func (c *pg) GetPublicationSequences(ctx context.Context, db, publicationName string) ([]*SequenceValue, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPublicationSequencesSQLTemplate, publicationName))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []*SequenceValue{}
+
+	for rows.Next() {
+		it := &SequenceValue{}
+
+		var lastValue sql.NullInt64
+
+		readable := false
+		// Scan
+		err = rows.Scan(&it.SchemaName, &it.SequenceName, &readable, &lastValue)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Check if sequence can be read
+		// ? Note: Otherwise, last value is NULL and sequence would be ignored silently
+		if !readable {
+			return nil, fmt.Errorf("sequence %s.%s cannot be read, SELECT or USAGE privilege is required", it.SchemaName, it.SequenceName)
+		}
+		// Check if sequence have been used
+		if lastValue.Valid {
+			it.LastValue = &lastValue.Int64
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// getPublicationSequenceOwners will return owners of sequences linked to published tables.
+func (c *pg) getPublicationSequenceOwners(ctx context.Context, db, publicationName string) ([]string, error) {
+	err := c.connect(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetPublicationSequenceOwnersSQLTemplate, publicationName))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []string{}
+
+	for rows.Next() {
+		it := ""
+		// Scan
+		err = rows.Scan(&it)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *pg) SetSequencesValue(ctx context.Context, db string, values []*SequenceValue) (err error) {
+	err = c.connect(db)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+
+			err = errors.Join(err, err2)
+		}
+	}()
+
+	// Loop over values
+	for _, it := range values {
+		// Ignore sequences without any value
+		if it.LastValue == nil {
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(SetSequenceValueSQLTemplate, it.SchemaName, it.SequenceName, *it.LastValue))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
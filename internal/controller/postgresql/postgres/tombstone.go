@@ -0,0 +1,353 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// Tombstone metadata are saved as a JSON comment on database.
+	GetDatabaseTombstonesSQLTemplate = `SELECT datname, shobj_description(oid, 'pg_database') FROM pg_database
+WHERE shobj_description(oid, 'pg_database') LIKE '{"tombstoneOf":%' ORDER BY datname`
+	CommentOnDatabaseSQLTemplate          = `COMMENT ON DATABASE "%s" IS %s`
+	GetDatabaseCommentSQLTemplate         = `SELECT shobj_description(oid, 'pg_database') FROM pg_database WHERE datname = '%s'`
+	GetDatabaseConnectGranteesSQLTemplate = `SELECT COALESCE(r.rolname, 'PUBLIC')
+FROM pg_database d
+CROSS JOIN aclexplode(COALESCE(d.datacl, acldefault('d', d.datdba))) a
+LEFT JOIN pg_roles r ON r.oid = a.grantee
+WHERE d.datname = '%s' AND a.privilege_type = 'CONNECT'`
+	RevokeConnectOnDatabaseSQLTemplate = `REVOKE CONNECT ON DATABASE "%s" FROM %s`
+	GrantConnectOnDatabaseSQLTemplate  = `GRANT CONNECT ON DATABASE "%s" TO %s`
+	publicGrantee                      = "PUBLIC"
+	tombstoneNameSeparator             = "_deleted_"
+	tombstoneNameTimeFormat            = "20060102150405"
+)
+
+type DatabaseTombstone struct {
+	// Tombstone database name
+	Name string `json:"-"`
+	// Original database name
+	Database  string    `json:"tombstoneOf"`
+	DeletedAt time.Time `json:"deletedAt"`
+	DropAfter time.Time `json:"dropAfter"`
+	// Roles that had CONNECT privilege before deletion
+	Grantees []string `json:"grantees,omitempty"`
+	// Database owner role before deletion
+	Owner string `json:"owner,omitempty"`
+	// Database roles kept for a future restore
+	Roles []string `json:"roles,omitempty"`
+}
+
+// BuildDatabaseTombstoneName will build a tombstone name for a database.
+// Database name is truncated to respect PostgreSQL identifier length.
+func BuildDatabaseTombstoneName(database string, deletedAt time.Time) string {
+	suffix := tombstoneNameSeparator + deletedAt.UTC().Format(tombstoneNameTimeFormat)
+
+	// Check length
+	if len(database)+len(suffix) > MaxIdentifierLength {
+		database = database[:MaxIdentifierLength-len(suffix)]
+	}
+
+	return database + suffix
+}
+
+func quoteGrantee(grantee string) string {
+	// PUBLIC is a keyword and mustn't be quoted
+	if grantee == publicGrantee {
+		return grantee
+	}
+
+	return pq.QuoteIdentifier(grantee)
+}
+
+func (c *pg) TombstoneDatabase(ctx context.Context, tombstone *DatabaseTombstone) error {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	// Get roles with connect privilege
+	grantees, err := c.getDatabaseConnectGrantees(ctx, tombstone.Database)
+	if err != nil {
+		return err
+	}
+
+	// Get metadata saved by a previous failed attempt
+	// ? Note: Grantees may have been already revoked, they must be kept for a future restore
+	previous, err := c.getDatabaseTombstoneMetadata(ctx, tombstone.Database)
+	if err != nil {
+		return err
+	}
+
+	// Merge grantees
+	if previous != nil {
+		for _, it := range previous.Grantees {
+			if !containsString(grantees, it) {
+				grantees = append(grantees, it)
+			}
+		}
+	}
+
+	// Save grantees for a future restore
+	tombstone.Grantees = grantees
+
+	// Build metadata
+	meta, err := json.Marshal(tombstone)
+	if err != nil {
+		return err
+	}
+
+	// Save metadata before any change in order to never lose grantees
+	// ? Note: Comment follows database on rename
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CommentOnDatabaseSQLTemplate, tombstone.Database, pq.QuoteLiteral(string(meta))))
+	if err != nil {
+		return err
+	}
+
+	// Revoke connect to avoid new sessions
+	err = c.revokeDatabaseConnect(ctx, tombstone.Database)
+	if err != nil {
+		return err
+	}
+
+	// Terminate existing sessions as database cannot be renamed with sessions on it
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(TerminateDatabaseSessionsSQLTemplate, tombstone.Database))
+	if err != nil {
+		return err
+	}
+
+	// Rename
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(RenameDatabaseSQLTemplate, tombstone.Database, tombstone.Name))
+	if err != nil {
+		return err
+	}
+
+	c.log.Info(fmt.Sprintf("Database %s renamed to tombstone %s", tombstone.Database, tombstone.Name))
+
+	return nil
+}
+
+// getDatabaseTombstoneMetadata will return tombstone metadata saved on a database that hasn't been renamed yet.
+// Caller must be connected on default database.
+func (c *pg) getDatabaseTombstoneMetadata(ctx context.Context, database string) (*DatabaseTombstone, error) {
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetDatabaseCommentSQLTemplate, database))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	meta := sql.NullString{}
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(&meta)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if there isn't any comment
+	if !meta.Valid {
+		return nil, nil
+	}
+
+	it := &DatabaseTombstone{}
+	// Parse
+	err = json.Unmarshal([]byte(meta.String), it)
+	// Ignore comments that aren't tombstone metadata of this database
+	if err != nil || it.Database != database {
+		return nil, nil
+	}
+
+	return it, nil
+}
+
+// getDatabaseConnectGrantees will return roles with CONNECT on database.
+// Caller must be connected on default database.
+func (c *pg) getDatabaseConnectGrantees(ctx context.Context, database string) ([]string, error) {
+	rows, err := c.db.QueryContext(ctx, fmt.Sprintf(GetDatabaseConnectGranteesSQLTemplate, database))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	grantees := []string{}
+
+	for rows.Next() {
+		it := ""
+		// Scan
+		err = rows.Scan(&it)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+		// Save
+		grantees = append(grantees, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return grantees, nil
+}
+
+// revokeDatabaseConnect will revoke CONNECT on database from all roles.
+// Caller must be connected on default database.
+func (c *pg) revokeDatabaseConnect(ctx context.Context, database string) error {
+	// Get roles with connect privilege
+	grantees, err := c.getDatabaseConnectGrantees(ctx, database)
+	if err != nil {
+		return err
+	}
+
+	// Loop over grantees
+	for _, grantee := range grantees {
+		_, err = c.db.ExecContext(ctx, fmt.Sprintf(RevokeConnectOnDatabaseSQLTemplate, database, quoteGrantee(grantee)))
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, it := range list {
+		if it == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *pg) ListDatabaseTombstones(ctx context.Context) ([]*DatabaseTombstone, error) {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, GetDatabaseTombstonesSQLTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res := []*DatabaseTombstone{}
+
+	for rows.Next() {
+		name, meta := "", ""
+		// Scan
+		err = rows.Scan(&name, &meta)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		it, err := parseDatabaseTombstone(name, meta)
+		// Ignore comments that aren't tombstone metadata
+		if err != nil {
+			continue
+		}
+		// Save
+		res = append(res, it)
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func parseDatabaseTombstone(name, meta string) (*DatabaseTombstone, error) {
+	it := &DatabaseTombstone{}
+	// Parse
+	err := json.Unmarshal([]byte(meta), it)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+	// Check content
+	if it.Database == "" {
+		return nil, fmt.Errorf("tombstone %s has no database name", name)
+	}
+	// Check if database have been renamed
+	// ? Note: Metadata are saved before rename, so a failed tombstone can have them
+	if it.Database == name {
+		return nil, fmt.Errorf("database %s hasn't been renamed to a tombstone", name)
+	}
+
+	it.Name = name
+
+	return it, nil
+}
+
+func (c *pg) RestoreDatabaseTombstone(ctx context.Context, tombstone *DatabaseTombstone) error {
+	err := c.connect(c.defaultDatabase)
+	if err != nil {
+		return err
+	}
+
+	// Rename
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(RenameDatabaseSQLTemplate, tombstone.Name, tombstone.Database))
+	if err != nil {
+		return err
+	}
+
+	// Remove metadata
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(CommentOnDatabaseSQLTemplate, tombstone.Database, "NULL"))
+	if err != nil {
+		return err
+	}
+
+	// Grant back connect
+	for _, grantee := range tombstone.Grantees {
+		// Check if role still exists
+		if grantee != publicGrantee {
+			exists, err := c.IsRoleExist(ctx, grantee)
+			// Check error
+			if err != nil {
+				return err
+			}
+			// Ignore dropped roles
+			if !exists {
+				continue
+			}
+		}
+
+		_, err = c.db.ExecContext(ctx, fmt.Sprintf(GrantConnectOnDatabaseSQLTemplate, tombstone.Database, quoteGrantee(grantee)))
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	c.log.Info(fmt.Sprintf("Database %s restored from tombstone %s", tombstone.Database, tombstone.Name))
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildDatabaseTombstoneName(t *testing.T) {
+	deletedAt := time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		database string
+		want     string
+	}{
+		{
+			name:     "short name",
+			database: "db",
+			want:     "db_deleted_20240305140709",
+		},
+		{
+			name:     "truncated name",
+			database: strings.Repeat("a", 60),
+			want:     strings.Repeat("a", 40) + "_deleted_20240305140709",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildDatabaseTombstoneName(tt.database, deletedAt)
+			if got != tt.want {
+				t.Errorf("BuildDatabaseTombstoneName() = %v, want %v", got, tt.want)
+			}
+
+			if len(got) > MaxIdentifierLength {
+				t.Errorf("BuildDatabaseTombstoneName() length = %d, must be <= %d", len(got), MaxIdentifierLength)
+			}
+		})
+	}
+}
+
+func TestParseDatabaseTombstone(t *testing.T) {
+	it, err := parseDatabaseTombstone(
+		"db_deleted_20240305140709",
+		`{"tombstoneOf":"db","deletedAt":"2024-03-05T14:07:09Z","dropAfter":"2024-03-12T14:07:09Z","grantees":["PUBLIC","admin"],`+
+			`"owner":"db-owner","roles":["db-owner","db-reader","db-writer"]}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if it.Name != "db_deleted_20240305140709" || it.Database != "db" {
+		t.Errorf("parseDatabaseTombstone() = %+v", it)
+	}
+
+	if !it.DropAfter.Equal(time.Date(2024, time.March, 12, 14, 7, 9, 0, time.UTC)) {
+		t.Errorf("parseDatabaseTombstone() drop after = %v", it.DropAfter)
+	}
+
+	if len(it.Grantees) != 2 {
+		t.Errorf("parseDatabaseTombstone() grantees = %v", it.Grantees)
+	}
+
+	if it.Owner != "db-owner" || len(it.Roles) != 3 {
+		t.Errorf("parseDatabaseTombstone() owner = %v, roles = %v", it.Owner, it.Roles)
+	}
+
+	// Not a tombstone
+	_, err = parseDatabaseTombstone("other", `{"tombstoneOf":""}`)
+	if err == nil {
+		t.Error("parseDatabaseTombstone() should fail without database name")
+	}
+
+	// Not renamed yet
+	_, err = parseDatabaseTombstone("db", `{"tombstoneOf":"db"}`)
+	if err == nil {
+		t.Error("parseDatabaseTombstone() should fail when database hasn't been renamed")
+	}
+}
@@ -6,11 +6,15 @@ import (
 )
 
 type UpdatePublicationBuilder struct {
-	newName    string
-	withPart   string
-	tablesPart string
-	tables     []string
-	schemaList []string
+	newName          string
+	withPart         string
+	tablesPart       string
+	dropTablesPart   string
+	tables           []string
+	dropTables       []string
+	schemaList       []string
+	schemaTables     []string
+	serverVersionNum int
 }
 
 func NewUpdatePublicationBuilder() *UpdatePublicationBuilder {
@@ -18,27 +22,36 @@ func NewUpdatePublicationBuilder() *UpdatePublicationBuilder {
 }
 
 func (b *UpdatePublicationBuilder) Build() {
+	// Compute tables and schema list depending on server version
+	tables, schemaList := computePublicationTablesAndSchemaList(b.serverVersionNum, b.tables, b.schemaList, b.schemaTables)
+
 	// Build
 	var res string
 
 	// Check if tables are set
-	if len(b.tables) != 0 {
-		res += "TABLE " + strings.Join(b.tables, ", ")
+	if len(tables) != 0 {
+		res += "TABLE " + strings.Join(tables, ", ")
 	}
 
 	// Check if schema are set
-	if len(b.schemaList) != 0 {
+	if len(schemaList) != 0 {
 		// Check if tables were added
-		if len(b.tables) != 0 {
+		if len(tables) != 0 {
 			// Append
 			res += ", "
 		}
 
-		res += "TABLES IN SCHEMA " + strings.Join(b.schemaList, ", ")
+		res += "TABLES IN SCHEMA " + strings.Join(schemaList, ", ")
 	}
 
 	// Save
 	b.tablesPart = res
+
+	// Check if tables must be dropped
+	// ? Note: This is only used when nothing is set as "SET" needs at least one element
+	if res == "" && len(b.dropTables) != 0 {
+		b.dropTablesPart = "TABLE " + strings.Join(b.dropTables, ", ")
+	}
 }
 
 func (b *UpdatePublicationBuilder) AddSetTable(name string, columns *[]string, additionalWhere *string) *UpdatePublicationBuilder {
@@ -60,12 +73,31 @@ func (b *UpdatePublicationBuilder) AddSetTable(name string, columns *[]string, a
 	return b
 }
 
+func (b *UpdatePublicationBuilder) AddDropTable(name string) *UpdatePublicationBuilder {
+	// Save
+	b.dropTables = append(b.dropTables, name)
+
+	return b
+}
+
 func (b *UpdatePublicationBuilder) SetTablesInSchema(schemaList []string) *UpdatePublicationBuilder {
 	b.schemaList = schemaList
 
 	return b
 }
 
+func (b *UpdatePublicationBuilder) SetServerVersionNum(version int) *UpdatePublicationBuilder {
+	b.serverVersionNum = version
+
+	return b
+}
+
+func (b *UpdatePublicationBuilder) SetTablesInSchemaTables(tables []string) *UpdatePublicationBuilder {
+	b.schemaTables = tables
+
+	return b
+}
+
 func (b *UpdatePublicationBuilder) RenameTo(newName string) *UpdatePublicationBuilder {
 	b.newName = newName
 
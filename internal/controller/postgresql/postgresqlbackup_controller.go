@@ -0,0 +1,1116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/config"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultBackupPgDumpImage         = "postgres:16-alpine"
+	DefaultBackupS3Image             = "amazon/aws-cli:2.15.0"
+	BackupJobSuffix                  = "-backup"
+	BackupCleanupJobSuffix           = "-cleanup"
+	BackupCredentialsSecretSuffix    = "-credentials"
+	JobRolePrefix                    = "pgjob-"
+	BackupURLSecretKey               = "POSTGRESQL_URL"
+	BackupS3AccessKeyIDSecretKey     = "AWS_ACCESS_KEY_ID"
+	BackupS3SecretAccessKeySecretKey = "AWS_SECRET_ACCESS_KEY"
+	backupDumpContainerName          = "pg-dump"
+	backupUploadContainerName        = "upload"
+	backupCleanupContainerName       = "cleanup"
+	backupDownloadContainerName      = "download"
+	restoreContainerName             = "restore"
+	restoreRoleEnvName               = "RESTORE_ROLE"
+	backupVolumeName                 = "backup"
+	backupVolumeMountPath            = "/backup"
+	backupJobNameLabelKey            = "job-name"
+	backupCleanupRequeueDelay        = 5 * time.Second
+	backupTimestampFormat            = "20060102T150405Z"
+)
+
+// Dump script.
+// First argument is the output file, other ones are given to pg_dump.
+// Artifact size is written in the termination message in order to be read by the operator.
+const backupDumpScript = `set -e
+file="$1"
+shift
+mkdir -p "$(dirname "$file")"
+pg_dump --dbname="$POSTGRESQL_URL" --file="$file" "$@"
+printf '{"size":%s}' "$(wc -c < "$file" | tr -d ' ')" > /dev/termination-log
+`
+
+// Restore scripts.
+// First argument is the input file.
+// Objects are created with the restore role in order to have a correct ownership.
+const (
+	restoreCustomFormatScript = `set -e
+pg_restore --dbname="$POSTGRESQL_URL" --no-owner --no-privileges --role="$RESTORE_ROLE" --exit-on-error "$1"
+`
+	restorePlainFormatScript = `set -e
+psql "$POSTGRESQL_URL" -v ON_ERROR_STOP=1 -c "SET ROLE \"$RESTORE_ROLE\"" -f "$1"
+`
+)
+
+// Forbidden pg_dump extra arguments.
+// Connection and output are managed by operator.
+var (
+	backupForbiddenShortExtraArgs = "dhpUfFwW"
+	// Allowed short options that take a value, next characters are the value.
+	backupShortExtraArgsWithValue = "eEjnNStTZ"
+	backupForbiddenLongExtraArgs  = []string{
+		"--dbname", "--host", "--port", "--username", "--file", "--format", "--no-password", "--password", "--role",
+	}
+)
+
+type backupTerminationMessage struct {
+	Size int64 `json:"size"`
+}
+
+// PostgresqlBackupReconciler reconciles a PostgresqlBackup object.
+type PostgresqlBackupReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackups/finalizers,verbs=update
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlBackup object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlBackup")
+
+	// Fetch the PostgresqlBackup instance
+	instance := &v1alpha1.PostgresqlBackup{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlBackupReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Deletion case
+	if !instance.GetDeletionTimestamp().IsZero() { //nolint:wsl
+		// Deletion detected
+
+		// Check if artifact must be deleted
+		// ? Note: Artifact exists only on successful backups
+		if instance.Spec.DeleteArtifactOnDelete && instance.Status.Phase == v1alpha1.BackupSucceededPhase {
+			done, err := r.manageArtifactCleanup(ctx, reqLogger, instance)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+			// Check if cleanup is still running
+			if !done {
+				return ctrl.Result{RequeueAfter: backupCleanupRequeueDelay}, nil
+			}
+		}
+
+		// Drop job role if job is still running
+		err := r.dropJobRole(ctx, reqLogger, instance)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		// Remove finalizer
+		controllerutil.RemoveFinalizer(instance, config.Finalizer)
+
+		// Update CR
+		err = r.Update(ctx, instance)
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		reqLogger.Info("Successfully deleted")
+		// Stop reconcile
+		return reconcile.Result{}, nil
+	}
+
+	// Check if backup is already finished
+	// ? Note: A backup is a one shot action
+	if instance.Status.Phase == v1alpha1.BackupSucceededPhase ||
+		(instance.Status.Phase == v1alpha1.BackupFailedPhase && instance.Status.JobName != "") {
+		return ctrl.Result{}, nil
+	}
+
+	// Check if job have already been launched
+	if instance.Status.JobName != "" {
+		return r.manageRunningJob(ctx, reqLogger, instance, originalPatch)
+	}
+
+	// Creation case
+
+	// Validate
+	err := validateBackupSpec(&instance.Spec)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres database is ready before continue
+	if !pgDB.Status.Ready {
+		reqLogger.Info("PostgresqlDatabase not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlDatabase isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres engine configuration is ready before continue
+	if !pgEngCfg.Status.Ready {
+		reqLogger.Info("PostgresqlEngineConfiguration not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Add finalizer and default values
+	updated, err := r.updateInstance(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if it has been updated in order to stop this reconcile loop here for the moment
+	if updated {
+		return ctrl.Result{}, nil
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg)
+
+	// Create job role and credentials secret
+	role, credSecret, err := manageJobRole(
+		ctx, r.Client, r.Scheme, pg, instance,
+		instance.Name+BackupCredentialsSecretSuffix,
+		pgEngCfg, pgDB.Status.Roles.Owner, pgDB.Status.Database, instance.Status.Role,
+	)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Save role
+	instance.Status.Role = role
+
+	// Build artifact path
+	artifactPath := buildBackupArtifactPath(instance, pgDB.Status.Database)
+
+	// Build job
+	job, err := r.newBackupJob(instance, credSecret.Name, artifactPath)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Create job
+	err = r.Create(ctx, job)
+	// Check error
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "BackupStarted", "Backup job %s created", job.Name)
+
+	// Save status
+	instance.Status.JobName = job.Name
+	instance.Status.ArtifactPath = artifactPath
+	instance.Status.Location = buildBackupLocation(instance.Spec.Destination, artifactPath)
+	instance.Status.StartTime = time.Now().UTC().Format(time.RFC3339)
+
+	return r.manageRunning(ctx, reqLogger, instance, originalPatch)
+}
+
+func (r *PostgresqlBackupReconciler) manageRunningJob(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Get job
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: instance.Status.JobName, Namespace: instance.Namespace}, job)
+	// Check error
+	if err != nil {
+		// Check if it is a not found error
+		if errors.IsNotFound(err) {
+			// Drop job role
+			// ? Note: Error is ignored here as job error is the one to report
+			_ = r.dropJobRole(ctx, reqLogger, instance)
+
+			return r.manageError(
+				ctx, reqLogger, instance, originalPatch,
+				errors.NewBadRequest(fmt.Sprintf("backup job %s not found", instance.Status.JobName)),
+			)
+		}
+
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check if job is finished
+	finished, succeeded := isJobFinished(job)
+	if !finished {
+		return r.manageRunning(ctx, reqLogger, instance, originalPatch)
+	}
+
+	// Drop job role as it isn't needed anymore
+	err = r.dropJobRole(ctx, reqLogger, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Get dump container termination message
+	msg, err := getJobContainerTerminationMessage(ctx, r.Client, job, backupDumpContainerName)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Save times
+	if job.Status.StartTime != nil {
+		instance.Status.StartTime = job.Status.StartTime.UTC().Format(time.RFC3339)
+	}
+
+	// Check if it has failed
+	if !succeeded {
+		// Build message
+		errMsg := fmt.Sprintf("backup job %s failed", job.Name)
+		if msg != "" {
+			errMsg = fmt.Sprintf("%s: %s", errMsg, strings.TrimSpace(msg))
+		}
+
+		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(errMsg))
+	}
+
+	// Parse termination message
+	tm := &backupTerminationMessage{}
+	// ? Note: Ignore parsing errors as size is only informative
+	_ = json.Unmarshal([]byte(msg), tm)
+	// Save
+	instance.Status.Size = tm.Size
+
+	// Save completion time and duration
+	if job.Status.CompletionTime != nil {
+		instance.Status.CompletionTime = job.Status.CompletionTime.UTC().Format(time.RFC3339)
+
+		if job.Status.StartTime != nil {
+			instance.Status.Duration = job.Status.CompletionTime.Sub(job.Status.StartTime.Time).String()
+		}
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "BackupSucceeded", "Backup saved to %s", instance.Status.Location)
+
+	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+}
+
+func getJobContainerTerminationMessage(
+	ctx context.Context,
+	cl client.Client,
+	job *batchv1.Job,
+	containerName string,
+) (string, error) {
+	// List pods
+	podList := &corev1.PodList{}
+	err := cl.List(ctx, podList, client.InNamespace(job.Namespace), client.MatchingLabels{backupJobNameLabelKey: job.Name})
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	// Loop over pods
+	for _, pod := range podList.Items {
+		// Loop over all container status
+		for _, st := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			// Check if it is the container and if it is terminated with a message
+			if st.Name == containerName && st.State.Terminated != nil && st.State.Terminated.Message != "" {
+				return st.State.Terminated.Message, nil
+			}
+		}
+	}
+
+	// Default
+	return "", nil
+}
+
+func (r *PostgresqlBackupReconciler) manageArtifactCleanup(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+) (bool, error) {
+	// Get cleanup job
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: instance.Name + BackupCleanupJobSuffix, Namespace: instance.Namespace}, job)
+	// Check error
+	if err != nil {
+		// Check if it isn't a not found error
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		// Build job
+		job, err = r.newCleanupJob(instance)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		reqLogger.Info("Creating artifact cleanup job", "job", job.Name)
+
+		return false, r.Create(ctx, job)
+	}
+
+	// Check if job is finished
+	finished, succeeded := isJobFinished(job)
+	if !finished {
+		return false, nil
+	}
+
+	// Check if it has failed
+	if !succeeded {
+		return false, errors.NewBadRequest(fmt.Sprintf("artifact cleanup job %s failed", job.Name))
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "ArtifactDeleted", "Backup artifact %s deleted", instance.Status.Location)
+
+	return true, nil
+}
+
+func (r *PostgresqlBackupReconciler) dropJobRole(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+) error {
+	// Check if there is a job role
+	if instance.Status.Role == "" {
+		return nil
+	}
+
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil {
+		// Check if it is a not found error
+		// ? Note: Role cannot be dropped without database, but this will be done with database roles
+		if errors.IsNotFound(err) {
+			logger.Info("PostgresqlDatabase not found, ignoring job role deletion", "role", instance.Status.Role)
+
+			instance.Status.Role = ""
+
+			return nil
+		}
+
+		return err
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return err
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return err
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
+
+	// Drop role
+	err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.Role, pgDB.Status.Roles.Owner, pgDB.Status.Database)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Clean status
+	instance.Status.Role = ""
+
+	return nil
+}
+
+// manageJobRole will create a temporary login role member of the database owner role
+// and a secret containing its connection url for a job.
+// Previous job role is dropped if it exists.
+// ? Note: Jobs mustn't use the engine configuration user as their image and arguments are chosen by users.
+func manageJobRole(
+	ctx context.Context,
+	cl client.Client,
+	scheme *runtime.Scheme,
+	pg postgres.PG,
+	owner client.Object,
+	secretName string,
+	pgEngCfg *v1alpha1.PostgresqlEngineConfiguration,
+	dbOwner, database, previousRole string,
+) (string, *corev1.Secret, error) {
+	// Check if a previous role must be dropped
+	if previousRole != "" {
+		err := pg.DropRoleAndDropAndChangeOwnedBy(ctx, previousRole, dbOwner, database)
+		// Check error
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	// Create role
+	role, login, password, err := utils.CreateMemberLoginRole(ctx, pg, JobRolePrefix, dbOwner)
+	// Check error
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Create or update credentials secret
+	secret, err := manageJobConnectionSecret(ctx, cl, scheme, owner, secretName, pgEngCfg, login, password, database)
+	// Check error
+	if err != nil {
+		// Drop created role
+		// ? Note: Error is ignored here as secret error is the one to report
+		_ = pg.DropRole(ctx, role)
+
+		return "", nil, err
+	}
+
+	return role, secret, nil
+}
+
+func manageJobConnectionSecret(
+	ctx context.Context,
+	cl client.Client,
+	scheme *runtime.Scheme,
+	owner client.Object,
+	name string,
+	pgEngCfg *v1alpha1.PostgresqlEngineConfiguration,
+	login, password, database string,
+) (*corev1.Secret, error) {
+	spec := pgEngCfg.Spec
+	// Build url
+	url := postgres.TemplatePostgresqlURLWithArgs(
+		spec.Host,
+		login,
+		password,
+		spec.URIArgs,
+		database,
+		spec.Port,
+	)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+		},
+		Data: map[string][]byte{
+			BackupURLSecretKey: []byte(url),
+		},
+	}
+
+	// Set owner references
+	err := controllerutil.SetControllerReference(owner, secret, scheme)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Create secret
+	err = cl.Create(ctx, secret)
+	// Check error
+	if err != nil {
+		// Check if it isn't an already exists error
+		if !errors.IsAlreadyExists(err) {
+			return nil, err
+		}
+
+		// Update it
+		return secret, cl.Update(ctx, secret)
+	}
+
+	return secret, nil
+}
+
+func (r *PostgresqlBackupReconciler) newBackupJob(
+	instance *v1alpha1.PostgresqlBackup,
+	credentialsSecretName, artifactPath string,
+) (*batchv1.Job, error) {
+	spec := instance.Spec
+	dest := spec.Destination
+
+	// Build pg_dump arguments
+	args := []string{"-c", backupDumpScript, backupDumpContainerName}
+
+	// Check if destination is a pvc
+	if dest.PersistentVolumeClaim != nil {
+		args = append(args, path.Join(backupVolumeMountPath, artifactPath))
+	} else {
+		args = append(args, path.Join(backupVolumeMountPath, path.Base(artifactPath)))
+	}
+
+	args = append(args, "--format="+string(spec.Format))
+	args = append(args, spec.ExtraArgs...)
+
+	// Dump container
+	dumpContainer := corev1.Container{
+		Name:                     backupDumpContainerName,
+		Image:                    spec.PgDumpImage,
+		Command:                  []string{"sh"},
+		Args:                     args,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Env: []corev1.EnvVar{
+			{
+				Name: BackupURLSecretKey,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecretName},
+						Key:                  BackupURLSecretKey,
+					},
+				},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: backupVolumeName, MountPath: backupVolumeMountPath}},
+	}
+
+	podSpec := corev1.PodSpec{RestartPolicy: corev1.RestartPolicyNever}
+
+	// Check if destination is a pvc
+	if dest.PersistentVolumeClaim != nil {
+		podSpec.Containers = []corev1.Container{dumpContainer}
+		podSpec.Volumes = []corev1.Volume{buildBackupPVCVolume(dest.PersistentVolumeClaim)}
+	} else {
+		// Dump in a temporary volume and upload it after
+		podSpec.InitContainers = []corev1.Container{dumpContainer}
+		podSpec.Containers = []corev1.Container{
+			buildBackupS3Container(
+				backupUploadContainerName,
+				dest.S3,
+				"cp", path.Join(backupVolumeMountPath, path.Base(artifactPath)), buildBackupLocation(dest, artifactPath),
+			),
+		}
+		podSpec.Volumes = []corev1.Volume{
+			{Name: backupVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		}
+	}
+
+	return newOwnedJob(r.Scheme, instance, instance.Name+BackupJobSuffix, podSpec)
+}
+
+func (r *PostgresqlBackupReconciler) newCleanupJob(instance *v1alpha1.PostgresqlBackup) (*batchv1.Job, error) {
+	dest := instance.Spec.Destination
+
+	podSpec := corev1.PodSpec{RestartPolicy: corev1.RestartPolicyNever}
+
+	// Check if destination is a pvc
+	if dest.PersistentVolumeClaim != nil {
+		podSpec.Containers = []corev1.Container{
+			{
+				Name:         backupCleanupContainerName,
+				Image:        instance.Spec.PgDumpImage,
+				Command:      []string{"rm", "-f", path.Join(backupVolumeMountPath, instance.Status.ArtifactPath)},
+				VolumeMounts: []corev1.VolumeMount{{Name: backupVolumeName, MountPath: backupVolumeMountPath}},
+			},
+		}
+		podSpec.Volumes = []corev1.Volume{buildBackupPVCVolume(dest.PersistentVolumeClaim)}
+	} else {
+		podSpec.Containers = []corev1.Container{
+			buildBackupS3Container(backupCleanupContainerName, dest.S3, "rm", instance.Status.Location),
+		}
+	}
+
+	return newOwnedJob(r.Scheme, instance, instance.Name+BackupCleanupJobSuffix, podSpec)
+}
+
+func newOwnedJob(
+	scheme *runtime.Scheme,
+	owner client.Object,
+	name string,
+	podSpec corev1.PodSpec,
+) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: lo.ToPtr(int32(0)),
+			Template: corev1.PodTemplateSpec{
+				Spec: podSpec,
+			},
+		},
+	}
+
+	// Set owner references
+	err := controllerutil.SetControllerReference(owner, job, scheme)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// newRestorePodSpec will build a pod spec restoring the artifact of a succeeded backup.
+func newRestorePodSpec(
+	backup *v1alpha1.PostgresqlBackup,
+	credentialsSecretName, role string,
+) corev1.PodSpec {
+	dest := backup.Spec.Destination
+
+	// Select script
+	script := restoreCustomFormatScript
+	if backup.Spec.Format == v1alpha1.PlainBackupFormat {
+		script = restorePlainFormatScript
+	}
+
+	// Compute file path
+	filePath := path.Join(backupVolumeMountPath, path.Base(backup.Status.ArtifactPath))
+	if dest.PersistentVolumeClaim != nil {
+		filePath = path.Join(backupVolumeMountPath, backup.Status.ArtifactPath)
+	}
+
+	// Restore container
+	restoreContainer := corev1.Container{
+		Name:                     restoreContainerName,
+		Image:                    backup.Spec.PgDumpImage,
+		Command:                  []string{"sh"},
+		Args:                     []string{"-c", script, restoreContainerName, filePath},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Env: []corev1.EnvVar{
+			{
+				Name: BackupURLSecretKey,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecretName},
+						Key:                  BackupURLSecretKey,
+					},
+				},
+			},
+			{Name: restoreRoleEnvName, Value: role},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: backupVolumeName, MountPath: backupVolumeMountPath}},
+	}
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Containers:    []corev1.Container{restoreContainer},
+	}
+
+	// Check if source is a pvc
+	if dest.PersistentVolumeClaim != nil {
+		podSpec.Volumes = []corev1.Volume{buildBackupPVCVolume(dest.PersistentVolumeClaim)}
+	} else {
+		// Download in a temporary volume before restore
+		podSpec.InitContainers = []corev1.Container{
+			buildBackupS3Container(backupDownloadContainerName, dest.S3, "cp", backup.Status.Location, filePath),
+		}
+		podSpec.Volumes = []corev1.Volume{
+			{Name: backupVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		}
+	}
+
+	return podSpec
+}
+
+func buildBackupPVCVolume(pvc *v1alpha1.PostgresqlBackupPVCDestination) corev1.Volume {
+	return corev1.Volume{
+		Name: backupVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.ClaimName},
+		},
+	}
+}
+
+func buildBackupS3Container(name string, s3 *v1alpha1.PostgresqlBackupS3Destination, args ...string) corev1.Container {
+	// Build env
+	env := []corev1.EnvVar{}
+	for _, k := range []string{BackupS3AccessKeyIDSecretKey, BackupS3SecretAccessKeySecretKey} {
+		env = append(env, corev1.EnvVar{
+			Name: k,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.CredentialsSecretName},
+					Key:                  k,
+				},
+			},
+		})
+	}
+
+	// Check if region is set
+	if s3.Region != "" {
+		env = append(env, corev1.EnvVar{Name: "AWS_DEFAULT_REGION", Value: s3.Region})
+	}
+
+	// Check if endpoint is set
+	if s3.Endpoint != "" {
+		env = append(env, corev1.EnvVar{Name: "AWS_ENDPOINT_URL", Value: s3.Endpoint})
+	}
+
+	return corev1.Container{
+		Name:         name,
+		Image:        s3.Image,
+		Command:      []string{"aws", "s3"},
+		Args:         args,
+		Env:          env,
+		VolumeMounts: []corev1.VolumeMount{{Name: backupVolumeName, MountPath: backupVolumeMountPath}},
+	}
+}
+
+func buildBackupArtifactPath(instance *v1alpha1.PostgresqlBackup, database string) string {
+	// Get extension
+	ext := "dump"
+	if instance.Spec.Format == v1alpha1.PlainBackupFormat {
+		ext = "sql"
+	}
+
+	// Get directory
+	dir := ""
+	if instance.Spec.Destination.PersistentVolumeClaim != nil {
+		dir = instance.Spec.Destination.PersistentVolumeClaim.Path
+	} else {
+		dir = instance.Spec.Destination.S3.Path
+	}
+
+	// ? Note: Creation timestamp is used to have a stable name
+	fileName := fmt.Sprintf("%s-%s.%s", database, instance.CreationTimestamp.UTC().Format(backupTimestampFormat), ext)
+
+	return strings.TrimPrefix(path.Join(dir, fileName), "/")
+}
+
+func buildBackupLocation(dest *v1alpha1.PostgresqlBackupDestination, artifactPath string) string {
+	// Check if destination is a pvc
+	if dest.PersistentVolumeClaim != nil {
+		return fmt.Sprintf("pvc://%s/%s", dest.PersistentVolumeClaim.ClaimName, artifactPath)
+	}
+
+	return fmt.Sprintf("s3://%s/%s", dest.S3.Bucket, artifactPath)
+}
+
+func isJobFinished(job *batchv1.Job) (finished bool, succeeded bool) {
+	// Loop over conditions
+	for _, c := range job.Status.Conditions {
+		// Check if condition is valid
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		// Check condition type
+		if c.Type == batchv1.JobComplete {
+			return true, true
+		} else if c.Type == batchv1.JobFailed {
+			return true, false
+		}
+	}
+
+	return false, false
+}
+
+func validateBackupSpec(spec *v1alpha1.PostgresqlBackupSpec) error {
+	// Check destination
+	if spec.Destination.PersistentVolumeClaim == nil && spec.Destination.S3 == nil {
+		return errors.NewBadRequest("destination must have a persistent volume claim or a s3 set")
+	}
+
+	// Check that only one destination is set
+	if spec.Destination.PersistentVolumeClaim != nil && spec.Destination.S3 != nil {
+		return errors.NewBadRequest("destination cannot have a persistent volume claim and a s3 set together")
+	}
+
+	// Check extra arguments
+	for _, arg := range spec.ExtraArgs {
+		if isBackupForbiddenExtraArg(arg) {
+			return errors.NewBadRequest(fmt.Sprintf("extra argument %s isn't allowed as connection and output are managed by operator", arg))
+		}
+	}
+
+	// Default
+	return nil
+}
+
+// isBackupForbiddenExtraArg will return true if argument changes connection or output of pg_dump.
+func isBackupForbiddenExtraArg(arg string) bool {
+	// Check long options
+	if strings.HasPrefix(arg, "--") {
+		name := strings.SplitN(arg, "=", 2)[0] //nolint:gomnd // Name and value
+
+		// ? Note: pg_dump accepts unambiguous prefixes of long options (e.g: --ho for --host)
+		return lo.ContainsBy(backupForbiddenLongExtraArgs, func(it string) bool { return strings.HasPrefix(it, name) })
+	}
+
+	// Check if it isn't a short option
+	if len(arg) < 2 || arg[0] != '-' { //nolint:gomnd // Dash and option
+		return false
+	}
+
+	// Loop over grouped short options (e.g: -vh)
+	for _, c := range arg[1:] {
+		// Check if option is forbidden
+		if strings.ContainsRune(backupForbiddenShortExtraArgs, c) {
+			return true
+		}
+		// Check if option takes a value, next characters are the value
+		if strings.ContainsRune(backupShortExtraArgsWithValue, c) {
+			return false
+		}
+	}
+
+	return false
+}
+
+func (r *PostgresqlBackupReconciler) updateInstance(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlBackup,
+) (bool, error) {
+	// Deep copy
+	oCopy := instance.DeepCopy()
+
+	// Add finalizer
+	controllerutil.AddFinalizer(instance, config.Finalizer)
+
+	// Set defaults
+	setBackupSpecDefaults(&instance.Spec)
+
+	// Check if update is needed
+	if !reflect.DeepEqual(oCopy.ObjectMeta, instance.ObjectMeta) || !reflect.DeepEqual(oCopy.Spec, instance.Spec) {
+		return true, r.Update(ctx, instance)
+	}
+
+	return false, nil
+}
+
+func setBackupSpecDefaults(spec *v1alpha1.PostgresqlBackupSpec) {
+	// Check if format isn't set
+	if spec.Format == "" {
+		// Set to default
+		spec.Format = v1alpha1.CustomBackupFormat
+	}
+
+	// Check if pg_dump image isn't set
+	if spec.PgDumpImage == "" {
+		// Set to default
+		spec.PgDumpImage = DefaultBackupPgDumpImage
+	}
+
+	// Check if s3 image isn't set
+	if spec.Destination != nil && spec.Destination.S3 != nil && spec.Destination.S3.Image == "" {
+		// Set to default
+		spec.Destination.S3.Image = DefaultBackupS3Image
+	}
+}
+
+func (r *PostgresqlBackupReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.BackupFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlBackupReconciler) manageRunning(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.BackupRunningPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Backup running")
+
+	return reconcile.Result{}, nil
+}
+
+func (r *PostgresqlBackupReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackup,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = v1alpha1.BackupSucceededPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PostgresqlBackup{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,378 @@
+package postgresql
+
+import (
+	gerrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("PostgresqlBackup tests", func() {
+	AfterEach(cleanupFunction)
+
+	Describe("Spec error", func() {
+		It("shouldn't accept input without any specs", func() {
+			err := k8sClient.Create(ctx, &postgresqlv1alpha1.PostgresqlBackup{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgbackupName,
+					Namespace: pgbackupNamespace,
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+
+			// Cast error
+			stErr, ok := err.(*apimachineryErrors.StatusError)
+
+			Expect(ok).To(BeTrue())
+
+			// Check that content is correct
+			causes := stErr.Status().Details.Causes
+
+			Expect(causes).To(HaveLen(2))
+
+			// Search all fields
+			fields := map[string]bool{
+				"spec.database":    false,
+				"spec.destination": false,
+			}
+
+			// Loop over all causes
+			for _, cause := range causes {
+				fields[cause.Field] = true
+			}
+
+			// Check that all fields are found
+			for key, value := range fields {
+				if !value {
+					err := fmt.Errorf("%s found be found in error causes", key)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+
+		It("should fail when no destination is provided", func() {
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database:    &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupFailedPhase))
+			Expect(item.Status.Message).To(Equal("destination must have a persistent volume claim or a s3 set"))
+		})
+
+		It("should fail when pvc and s3 destinations are provided", func() {
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+					S3:                    &postgresqlv1alpha1.PostgresqlBackupS3Destination{Bucket: "bucket", CredentialsSecretName: "creds"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupFailedPhase))
+			Expect(item.Status.Message).To(Equal("destination cannot have a persistent volume claim and a s3 set together"))
+		})
+
+		It("should fail when a connection extra argument is provided", func() {
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+				},
+				ExtraArgs: []string{"--no-owner", "--host=other-host"},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupFailedPhase))
+			Expect(item.Status.Message).To(Equal("extra argument --host=other-host isn't allowed as connection and output are managed by operator"))
+		})
+
+		It("should fail when an abbreviated connection extra argument is provided", func() {
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+				},
+				ExtraArgs: []string{"--no-owner", "--ho=other-host"},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupFailedPhase))
+			Expect(item.Status.Message).To(Equal("extra argument --ho=other-host isn't allowed as connection and output are managed by operator"))
+		})
+
+		It("should fail when a connection extra argument is grouped with other short options", func() {
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+				},
+				ExtraArgs: []string{"-vhother-host"},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupFailedPhase))
+			Expect(item.Status.Message).To(Equal("extra argument -vhother-host isn't allowed as connection and output are managed by operator"))
+		})
+	})
+
+	Describe("Job", func() {
+		It("should create a backup job to a pvc", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName, Path: "daily"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupRunningPhase))
+			Expect(item.Status.JobName).To(Equal(pgbackupName + BackupJobSuffix))
+			Expect(item.Status.ArtifactPath).To(Equal(
+				fmt.Sprintf("daily/%s-%s.dump", pgdbDBName, item.CreationTimestamp.UTC().Format("20060102T150405Z")),
+			))
+			Expect(item.Status.Location).To(Equal(fmt.Sprintf("pvc://%s/%s", pgbackupClaimName, item.Status.ArtifactPath)))
+			Expect(item.Spec.Format).To(Equal(postgresqlv1alpha1.CustomBackupFormat))
+			Expect(item.Spec.PgDumpImage).To(Equal(DefaultBackupPgDumpImage))
+
+			// Check credentials secret
+			sec, err := getSecret(ctx, k8sClient, pgbackupName+BackupCredentialsSecretSuffix, pgbackupNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Contains(string(sec.Data[BackupURLSecretKey]), pgdbDBName)).To(BeTrue())
+
+			// Check that job role is a temporary member of owner and engine user isn't used
+			Expect(strings.HasPrefix(item.Status.Role, JobRolePrefix)).To(BeTrue())
+			Expect(strings.Contains(string(sec.Data[BackupURLSecretKey]), "//"+item.Status.Role+":")).To(BeTrue())
+			Expect(strings.Contains(string(sec.Data[BackupURLSecretKey]), "//"+postgresUser+":")).To(BeFalse())
+
+			pgdb := &postgresqlv1alpha1.PostgresqlDatabase{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pgdbName, Namespace: pgdbNamespace}, pgdb)).To(Succeed())
+
+			res, err := rawSQLQueryBoolInDB(
+				fmt.Sprintf(`SELECT pg_has_role('%s', '%s', 'MEMBER')`, item.Status.Role, pgdb.Status.Roles.Owner),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+
+			// Check job
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: item.Status.JobName, Namespace: pgbackupNamespace}, job)).To(Succeed())
+			Expect(job.OwnerReferences).To(HaveLen(1))
+			Expect(job.OwnerReferences[0].Name).To(Equal(pgbackupName))
+			Expect(job.Spec.Template.Spec.InitContainers).To(BeEmpty())
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal(DefaultBackupPgDumpImage))
+			Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				"/backup/"+item.Status.ArtifactPath,
+				"--format=custom",
+			))
+			Expect(job.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pgbackupClaimName))
+		})
+
+		It("should create a backup job to a s3 compatible storage", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Format:   postgresqlv1alpha1.PlainBackupFormat,
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					S3: &postgresqlv1alpha1.PostgresqlBackupS3Destination{
+						Bucket:                "backups",
+						Path:                  "/db",
+						Endpoint:              "http://minio:9000",
+						CredentialsSecretName: "minio-creds",
+					},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupRunningPhase))
+			Expect(item.Status.Location).To(Equal(
+				fmt.Sprintf("s3://backups/db/%s-%s.sql", pgdbDBName, item.CreationTimestamp.UTC().Format("20060102T150405Z")),
+			))
+			Expect(item.Spec.Destination.S3.Image).To(Equal(DefaultBackupS3Image))
+
+			// Check job
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: item.Status.JobName, Namespace: pgbackupNamespace}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.InitContainers[0].Args).To(ContainElement("--format=plain"))
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElement(item.Status.Location))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AWS_ENDPOINT_URL", Value: "http://minio:9000"}))
+			Expect(job.Spec.Template.Spec.Volumes[0].EmptyDir).NotTo(BeNil())
+		})
+
+		It("should report size and duration when job succeeded", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+				},
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupRunningPhase))
+			Expect(item.Status.Role).NotTo(Equal(""))
+
+			// Create a fake pod for the job
+			pod := &corev1.Pod{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      item.Status.JobName + "-pod",
+					Namespace: pgbackupNamespace,
+					Labels:    map[string]string{"job-name": item.Status.JobName},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "pg-dump", Image: DefaultBackupPgDumpImage}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+			defer func() {
+				Expect(deleteObject(ctx, k8sClient, pod.Name, pod.Namespace, &corev1.Pod{})).To(Succeed())
+			}()
+
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name:  "pg-dump",
+					Image: DefaultBackupPgDumpImage,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"size":42}`}},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
+
+			// Mark job as succeeded
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: item.Status.JobName, Namespace: pgbackupNamespace}, job)).To(Succeed())
+
+			start := v1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+			end := v1.NewTime(start.Add(time.Minute))
+			job.Status.StartTime = &start
+			job.Status.CompletionTime = &end
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			updatedItem := &postgresqlv1alpha1.PostgresqlBackup{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.Phase != postgresqlv1alpha1.BackupSucceededPhase {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(updatedItem.Status.Ready).To(BeTrue())
+			Expect(updatedItem.Status.Size).To(Equal(int64(42)))
+			Expect(updatedItem.Status.Duration).To(Equal("1m0s"))
+			Expect(updatedItem.Status.CompletionTime).NotTo(Equal(""))
+
+			// Check that job role is dropped
+			Expect(updatedItem.Status.Role).To(Equal(""))
+
+			res, err := rawSQLQueryBoolInDB(
+				fmt.Sprintf(`SELECT NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = '%s')`, item.Status.Role),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should report failure when job failed", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+					PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+				},
+			})
+
+			// Mark job as failed
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: item.Status.JobName, Namespace: pgbackupNamespace}, job)).To(Succeed())
+
+			job.Status.Failed = 1
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			updatedItem := &postgresqlv1alpha1.PostgresqlBackup{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.Phase != postgresqlv1alpha1.BackupFailedPhase {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(updatedItem.Status.Ready).To(BeFalse())
+			Expect(updatedItem.Status.Message).To(Equal(fmt.Sprintf("backup job %s failed", item.Status.JobName)))
+		})
+	})
+})
@@ -0,0 +1,448 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const BackupScheduleLabelKey = "postgresql.easymile.com/backup-schedule"
+
+// PostgresqlBackupScheduleReconciler reconciles a PostgresqlBackupSchedule object.
+type PostgresqlBackupScheduleReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackupschedules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackupschedules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackupschedules/finalizers,verbs=update
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackups,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlBackupSchedule object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlBackupScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlBackupSchedule")
+
+	// Fetch the PostgresqlBackupSchedule instance
+	instance := &v1alpha1.PostgresqlBackupSchedule{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlBackupScheduleReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// ? Note: No deletion case as created backups aren't owned by the schedule.
+	// This is done on purpose to avoid losing artifacts when a schedule is removed.
+
+	// Validate
+	sched, err := r.validate(instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// List backups created by this schedule
+	backups, err := r.listScheduledBackups(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Save last successful backup
+	for _, b := range backups {
+		if b.Status.Phase == v1alpha1.BackupSucceededPhase {
+			instance.Status.LastSuccessfulBackupName = b.Name
+
+			break
+		}
+	}
+
+	// Apply retention
+	err = r.manageRetention(ctx, reqLogger, instance, backups)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check if it is suspended
+	if instance.Spec.Suspend {
+		instance.Status.NextScheduleTime = ""
+
+		return r.manageSuccess(ctx, reqLogger, instance, originalPatch, v1alpha1.BackupScheduleSuspendedPhase, ctrl.Result{})
+	}
+
+	now := time.Now().UTC()
+
+	// Get last schedule time
+	last := instance.CreationTimestamp.UTC()
+	if instance.Status.LastScheduleTime != "" {
+		last, err = time.Parse(time.RFC3339, instance.Status.LastScheduleTime)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+	}
+
+	// Find latest missed schedule time
+	// ? Note: Only one backup is created when multiple schedules have been missed
+	var missed time.Time
+
+	for t := sched.Next(last); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		missed = t
+	}
+
+	// Check if a backup must be created
+	if !missed.IsZero() {
+		name, err := r.createScheduledBackup(ctx, instance, missed)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "BackupCreated", "PostgresqlBackup %s created", name)
+
+		// Save status
+		instance.Status.LastScheduleTime = missed.Format(time.RFC3339)
+		instance.Status.LastBackupName = name
+	}
+
+	// Compute next schedule time
+	next := sched.Next(now)
+	// Check if nothing have been found
+	if next.IsZero() {
+		instance.Status.NextScheduleTime = ""
+
+		return r.manageSuccess(ctx, reqLogger, instance, originalPatch, v1alpha1.BackupScheduleActivePhase, ctrl.Result{})
+	}
+
+	// Save
+	instance.Status.NextScheduleTime = next.Format(time.RFC3339)
+
+	return r.manageSuccess(
+		ctx, reqLogger, instance, originalPatch,
+		v1alpha1.BackupScheduleActivePhase, ctrl.Result{RequeueAfter: next.Sub(now)},
+	)
+}
+
+func (r *PostgresqlBackupScheduleReconciler) createScheduledBackup(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+	scheduleTime time.Time,
+) (string, error) {
+	backup := &v1alpha1.PostgresqlBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", instance.Name, scheduleTime.Unix()),
+			Namespace: instance.Namespace,
+			Labels: map[string]string{
+				BackupScheduleLabelKey: instance.Name,
+			},
+		},
+		Spec: *instance.Spec.BackupSpec.DeepCopy(),
+	}
+
+	// Create
+	err := r.Create(ctx, backup)
+	// Check error
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	return backup.Name, nil
+}
+
+func (r *PostgresqlBackupScheduleReconciler) listScheduledBackups(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+) ([]*v1alpha1.PostgresqlBackup, error) {
+	// List
+	list := &v1alpha1.PostgresqlBackupList{}
+	err := r.List(ctx, list, client.InNamespace(instance.Namespace), client.MatchingLabels{BackupScheduleLabelKey: instance.Name})
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*v1alpha1.PostgresqlBackup{}
+	for i := range list.Items {
+		res = append(res, &list.Items[i])
+	}
+
+	// Sort by creation date, newest first
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[j].CreationTimestamp.Before(&res[i].CreationTimestamp)
+	})
+
+	return res, nil
+}
+
+func (r *PostgresqlBackupScheduleReconciler) manageRetention(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+	backups []*v1alpha1.PostgresqlBackup,
+) error {
+	retention := instance.Spec.Retention
+	// Check if there is a retention
+	if retention == nil {
+		return nil
+	}
+
+	// Parse max age
+	var maxAge time.Duration
+
+	if retention.MaxAge != "" {
+		var err error
+		// Parse
+		maxAge, err = time.ParseDuration(retention.MaxAge)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Counters of kept backups
+	// ? Note: Failed backups are counted separately as they cannot be restored
+	keptSucceeded := 0
+	keptFailed := 0
+
+	// Loop over backups
+	for _, b := range backups {
+		// Ignore backups not finished
+		if b.Status.Phase != v1alpha1.BackupSucceededPhase && b.Status.Phase != v1alpha1.BackupFailedPhase {
+			continue
+		}
+
+		// Select counter
+		kept := &keptFailed
+		if b.Status.Phase == v1alpha1.BackupSucceededPhase {
+			kept = &keptSucceeded
+
+			// Most recent succeeded backup is always kept
+			if keptSucceeded == 0 {
+				keptSucceeded++
+
+				continue
+			}
+		}
+
+		// Check if it must be deleted
+		tooMany := retention.KeepLast != nil && *kept >= *retention.KeepLast
+		tooOld := maxAge != 0 && time.Since(b.CreationTimestamp.Time) > maxAge
+
+		if !tooMany && !tooOld {
+			*kept++
+
+			continue
+		}
+
+		// Ignore already deleted
+		if !b.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		reqLogger.Info("Deleting backup because of retention policy", "backup", b.Name)
+
+		// Delete
+		err := r.Delete(ctx, b)
+		// Check error
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "BackupDeleted", "PostgresqlBackup %s deleted by retention policy", b.Name)
+	}
+
+	return nil
+}
+
+func (*PostgresqlBackupScheduleReconciler) validate(
+	instance *v1alpha1.PostgresqlBackupSchedule,
+) (*utils.CronSchedule, error) {
+	// Parse schedule
+	sched, err := utils.ParseCronSchedule(instance.Spec.Schedule)
+	// Check error
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	// Check retention max age
+	if instance.Spec.Retention != nil && instance.Spec.Retention.MaxAge != "" {
+		_, err = time.ParseDuration(instance.Spec.Retention.MaxAge)
+		// Check error
+		if err != nil {
+			return nil, errors.NewBadRequest("retention max age must be a valid duration")
+		}
+	}
+
+	// Validate backup spec
+	err = validateBackupSpec(instance.Spec.BackupSpec)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return sched, nil
+}
+
+func (r *PostgresqlBackupScheduleReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.BackupScheduleFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlBackupScheduleReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlBackupSchedule,
+	originalPatch client.Patch,
+	phase v1alpha1.BackupScheduleStatusPhase,
+	res ctrl.Result,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = phase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return res, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlBackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PostgresqlBackupSchedule{}).
+		Complete(r)
+}
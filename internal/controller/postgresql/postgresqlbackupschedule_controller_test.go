@@ -0,0 +1,240 @@
+package postgresql
+
+import (
+	gerrors "errors"
+	"fmt"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("PostgresqlBackupSchedule tests", func() {
+	AfterEach(cleanupFunction)
+
+	backupSpec := func() *postgresqlv1alpha1.PostgresqlBackupSpec {
+		return &postgresqlv1alpha1.PostgresqlBackupSpec{
+			Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+				PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+			},
+		}
+	}
+
+	Describe("Spec error", func() {
+		It("shouldn't accept input without any specs", func() {
+			err := k8sClient.Create(ctx, &postgresqlv1alpha1.PostgresqlBackupSchedule{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgbackupScheduleName,
+					Namespace: pgbackupNamespace,
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+
+			// Cast error
+			stErr, ok := err.(*apimachineryErrors.StatusError)
+
+			Expect(ok).To(BeTrue())
+
+			// Check that content is correct
+			causes := stErr.Status().Details.Causes
+
+			Expect(causes).To(HaveLen(2))
+
+			// Search all fields
+			fields := map[string]bool{
+				"spec.schedule":   false,
+				"spec.backupSpec": false,
+			}
+
+			// Loop over all causes
+			for _, cause := range causes {
+				fields[cause.Field] = true
+			}
+
+			// Check that all fields are found
+			for key, value := range fields {
+				if !value {
+					err := fmt.Errorf("%s found be found in error causes", key)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+
+		It("should fail with an invalid schedule", func() {
+			item := setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "* * *",
+				BackupSpec: backupSpec(),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupScheduleFailedPhase))
+			Expect(item.Status.Message).To(Equal(`cron expression "* * *" must have 5 fields`))
+		})
+
+		It("should fail with an invalid retention max age", func() {
+			item := setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "@daily",
+				BackupSpec: backupSpec(),
+				Retention:  &postgresqlv1alpha1.PostgresqlBackupRetention{MaxAge: "fake"},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupScheduleFailedPhase))
+			Expect(item.Status.Message).To(Equal("retention max age must be a valid duration"))
+		})
+	})
+
+	Describe("Schedule", func() {
+		It("should be suspended", func() {
+			item := setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "@daily",
+				BackupSpec: backupSpec(),
+				Suspend:    true,
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupScheduleSuspendedPhase))
+			Expect(item.Status.NextScheduleTime).To(Equal(""))
+		})
+
+		It("should compute next schedule time", func() {
+			item := setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "@daily",
+				BackupSpec: backupSpec(),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.BackupScheduleActivePhase))
+			Expect(item.Status.NextScheduleTime).NotTo(Equal(""))
+			Expect(item.Status.LastBackupName).To(Equal(""))
+		})
+
+		It("should create a backup on schedule", func() {
+			item := setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "* * * * *",
+				BackupSpec: backupSpec(),
+			})
+
+			updatedItem := &postgresqlv1alpha1.PostgresqlBackupSchedule{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.LastBackupName == "" {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				2*generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(updatedItem.Status.LastScheduleTime).NotTo(Equal(""))
+
+			// Check backup
+			list := &postgresqlv1alpha1.PostgresqlBackupList{}
+			Expect(k8sClient.List(
+				ctx, list,
+				client.InNamespace(pgbackupNamespace),
+				client.MatchingLabels{BackupScheduleLabelKey: pgbackupScheduleName},
+			)).To(Succeed())
+			Expect(list.Items).NotTo(BeEmpty())
+			Expect(list.Items[0].Spec.Destination.PersistentVolumeClaim.ClaimName).To(Equal(pgbackupClaimName))
+		})
+
+		It("should apply retention on finished backups", func() {
+			// Create finished backups
+			// ? Note: They will fail as database doesn't exist
+			for _, name := range []string{pgbackupScheduleName + "-1", pgbackupScheduleName + "-2"} {
+				Expect(k8sClient.Create(ctx, &postgresqlv1alpha1.PostgresqlBackup{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      name,
+						Namespace: pgbackupNamespace,
+						Labels:    map[string]string{BackupScheduleLabelKey: pgbackupScheduleName},
+					},
+					Spec: *backupSpec(),
+				})).To(Succeed())
+			}
+
+			list := &postgresqlv1alpha1.PostgresqlBackupList{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.List(
+						ctx, list,
+						client.InNamespace(pgbackupNamespace),
+						client.MatchingLabels{BackupScheduleLabelKey: pgbackupScheduleName},
+					)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check that all are finished
+					for _, it := range list.Items {
+						if it.Status.Phase != postgresqlv1alpha1.BackupFailedPhase {
+							return gerrors.New("hasn't been updated by operator")
+						}
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			setupPGBackupSchedule(postgresqlv1alpha1.PostgresqlBackupScheduleSpec{
+				Schedule:   "@daily",
+				BackupSpec: backupSpec(),
+				Retention:  &postgresqlv1alpha1.PostgresqlBackupRetention{KeepLast: starAny(1)},
+			})
+
+			Eventually(
+				func() error {
+					err := k8sClient.List(
+						ctx, list,
+						client.InNamespace(pgbackupNamespace),
+						client.MatchingLabels{BackupScheduleLabelKey: pgbackupScheduleName},
+					)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check that retention have been applied
+					if len(list.Items) != 1 {
+						return gerrors.New("retention hasn't been applied by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+		})
+	})
+})
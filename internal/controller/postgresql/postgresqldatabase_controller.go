@@ -20,15 +20,19 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	"github.com/easymile/postgresql-operator/internal/controller/config"
 	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
@@ -39,9 +43,15 @@ import (
 )
 
 const (
-	readerPrivs               = "SELECT"
-	writerPrivs               = "SELECT,INSERT,DELETE,UPDATE"
-	defaultPGPublicSchemaName = "public"
+	readerPrivs                    = "SELECT"
+	writerPrivs                    = "SELECT,INSERT,DELETE,UPDATE"
+	defaultPGPublicSchemaName      = "public"
+	RestoreJobSuffix               = "-restore"
+	RestoreCredentialsSecretSuffix = "-restore-credentials"
+	databaseInitSourceKindDatabase = "PostgresqlDatabase"
+	databaseInitSourceKindBackup   = "PostgresqlBackup"
+	DefaultDatabaseDropWaitTimeout = 5 * time.Minute
+	databaseDropWaitRequeueDelay   = 5 * time.Second
 )
 
 // PostgresqlDatabaseReconciler reconciles a PostgresqlDatabase object.
@@ -59,6 +69,9 @@ type PostgresqlDatabaseReconciler struct {
 //+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqldatabases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqldatabases/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlbackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -135,6 +148,11 @@ func (r *PostgresqlDatabaseReconciler) mainReconcile(
 ) (ctrl.Result, error) {
 	// Deletion case
 	if !instance.GetDeletionTimestamp().IsZero() {
+		// Check if deletion protection is enabled
+		if common.IsDeletionProtected(instance, instance.Spec.DeletionProtection) {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(common.DeletionProtectionMessage))
+		}
+
 		// Deletion in progress detected
 		// Test should delete database
 		shouldDelete, err := r.shouldDropDatabase(ctx, instance)
@@ -144,10 +162,14 @@ func (r *PostgresqlDatabaseReconciler) mainReconcile(
 		// Check if should delete database is flagged
 		if shouldDelete {
 			// Drop database
-			err = r.manageDropDatabase(ctx, reqLogger, instance)
+			done, err := r.manageDropDatabase(ctx, reqLogger, instance)
 			if err != nil {
 				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 			}
+			// Check if drop is waiting for sessions to be closed
+			if !done {
+				return r.manageDropWaiting(ctx, reqLogger, instance, originalPatch)
+			}
 		} else {
 			// Close saved pools
 			err = utils.CloseDatabaseSavedPoolsForName(instance, instance.Spec.Database)
@@ -232,6 +254,33 @@ func (r *PostgresqlDatabaseReconciler) mainReconcile(
 		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(errStr))
 	}
 
+	// Check drop wait timeout
+	if instance.Spec.DropWaitTimeout != "" {
+		_, err = time.ParseDuration(instance.Spec.DropWaitTimeout)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("drop wait timeout must be a valid duration"))
+		}
+	}
+
+	// Check extension options
+	for _, it := range instance.Spec.Extensions.Options {
+		if !funk.ContainsString(instance.Spec.Extensions.List, it.Name) {
+			errStr := fmt.Sprintf("extension %s has options but isn't declared in extensions list", it.Name)
+
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(errStr))
+		}
+	}
+
+	// Check soft delete retention
+	if instance.Spec.SoftDelete != nil {
+		_, err = time.ParseDuration(instance.Spec.SoftDelete.Retention)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("soft delete retention must be a valid duration"))
+		}
+	}
+
 	// Create owner role
 	err = r.manageOwnerRole(ctx, pg, owner, instance, pgEngCfg.Spec.AllowGrantAdminOption)
 	if err != nil {
@@ -239,7 +288,7 @@ func (r *PostgresqlDatabaseReconciler) mainReconcile(
 	}
 
 	// Create or update database
-	err = r.manageDBCreationOrUpdate(ctx, pg, instance, owner)
+	err = r.manageDBCreationOrUpdate(ctx, pg, pgEngCfg, instance, owner)
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
 	}
@@ -256,24 +305,42 @@ func (r *PostgresqlDatabaseReconciler) mainReconcile(
 		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
 	}
 
-	// Manage extensions
-	err = r.manageExtensions(ctx, pg, instance)
-	if err != nil {
-		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
+	// Manage initialization from source
+	// ? Note: This must be done before extensions and schemas to avoid conflicts with restored objects
+	if instance.Status.Initialization != nil &&
+		instance.Status.Initialization.Phase != postgresqlv1alpha1.DatabaseInitializationCompletedPhase &&
+		instance.Status.Initialization.Phase != postgresqlv1alpha1.DatabaseInitializationSkippedPhase {
+		done, err := r.manageInitialization(ctx, pg, pgEngCfg, instance, owner, reader, writer)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+		// Check if initialization is still running
+		if !done {
+			return r.manageInitializing(ctx, reqLogger, instance, originalPatch)
+		}
 	}
 
 	// Manage schema
+	// Schemas are managed first as extensions can be installed in them
 	err = r.manageSchemas(ctx, pg, instance)
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
 	}
 
+	// Manage extensions
+	err = r.manageExtensions(ctx, pg, instance)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
+	}
+
 	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
 }
 
-func (*PostgresqlDatabaseReconciler) manageDBCreationOrUpdate(
+func (r *PostgresqlDatabaseReconciler) manageDBCreationOrUpdate(
 	ctx context.Context,
 	pg postgres.PG,
+	pgEngCfg *postgresqlv1alpha1.PostgresqlEngineConfiguration,
 	instance *postgresqlv1alpha1.PostgresqlDatabase,
 	owner string,
 ) error {
@@ -307,14 +374,38 @@ func (*PostgresqlDatabaseReconciler) manageDBCreationOrUpdate(
 	if err != nil {
 		return err
 	}
+	// Check if a soft deleted database can be restored
+	if !exists {
+		exists, err = r.manageDBTombstoneRestore(ctx, pg, instance, owner)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
 	// Check if exists
 	if !exists {
-		// Create database
-		err := pg.CreateDB(ctx, instance.Spec.Database, owner)
+		// Check if database must be initialized from a source
+		// ? Note: Initialization is only done once, when database is created by the operator
+		if instance.Spec.InitFrom != nil && instance.Status.Initialization == nil {
+			err = r.manageDBCreationFromSource(ctx, pg, pgEngCfg, instance, owner)
+		} else {
+			// Create database
+			err = pg.CreateDB(ctx, instance.Spec.Database, owner)
+		}
+		// Check error
 		if err != nil {
 			return err
 		}
 	} else {
+		// Check if initialization was asked on an already existing database
+		if instance.Spec.InitFrom != nil && instance.Status.Initialization == nil {
+			instance.Status.Initialization = &postgresqlv1alpha1.DatabaseInitializationStatus{
+				Phase: postgresqlv1alpha1.DatabaseInitializationSkippedPhase,
+			}
+
+			r.Recorder.Event(instance, "Warning", "InitializationSkipped", "Database already exists, initialization from source skipped")
+		}
+
 		// Get database owner
 		currentOwner, err := pg.GetDatabaseOwner(ctx, instance.Spec.Database)
 		if err != nil {
@@ -336,32 +427,379 @@ func (*PostgresqlDatabaseReconciler) manageDBCreationOrUpdate(
 	return nil
 }
 
+func (r *PostgresqlDatabaseReconciler) manageDBCreationFromSource(
+	ctx context.Context,
+	pg postgres.PG,
+	pgEngCfg *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	owner string,
+) error {
+	initFrom := instance.Spec.InitFrom
+
+	// Check that only one source is set
+	if (initFrom.Database == nil) == (initFrom.Backup == nil) {
+		return errors.NewBadRequest("init from must have a database or a backup set")
+	}
+
+	// Check if it is a restore
+	if initFrom.Backup != nil {
+		// Find backup to ensure it can be restored before creating database
+		backup, err := r.findInitBackup(ctx, instance)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Create empty database
+		err = pg.CreateDB(ctx, instance.Spec.Database, owner)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Save status
+		instance.Status.Initialization = &postgresqlv1alpha1.DatabaseInitializationStatus{
+			Phase:      postgresqlv1alpha1.DatabaseInitializationRunningPhase,
+			SourceKind: databaseInitSourceKindBackup,
+			Source:     backup.Namespace + "/" + backup.Name,
+		}
+
+		return nil
+	}
+
+	// Clone case
+
+	// Find source database
+	sourceDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, initFrom.Database, instance.Namespace)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check that source is ready
+	if !sourceDB.Status.Ready || sourceDB.Status.Database == "" {
+		return errors.NewBadRequest(fmt.Sprintf("source PostgresqlDatabase %s/%s isn't ready", sourceDB.Namespace, sourceDB.Name))
+	}
+
+	// Check that source is on the same engine
+	sourceEngineKey := utils.CreateNameKey(sourceDB.Spec.EngineConfiguration.Name, sourceDB.Spec.EngineConfiguration.Namespace, sourceDB.Namespace)
+	if sourceEngineKey != utils.CreateNameKey(pgEngCfg.Name, pgEngCfg.Namespace, pgEngCfg.Namespace) {
+		return errors.NewBadRequest("source PostgresqlDatabase must be on the same PostgresqlEngineConfiguration")
+	}
+
+	// Close operator saved pools on source database
+	err = utils.CloseDatabaseSavedPoolsForName(sourceDB, sourceDB.Status.Database)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Terminate source database sessions as template database mustn't be accessed during copy
+	err = pg.TerminateDatabaseSessions(ctx, sourceDB.Status.Database)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create database from template
+	err = pg.CreateDBFromTemplate(ctx, instance.Spec.Database, owner, sourceDB.Status.Database)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "DatabaseCloned", "Database cloned from %s", sourceDB.Status.Database)
+
+	// Save status
+	instance.Status.Initialization = &postgresqlv1alpha1.DatabaseInitializationStatus{
+		Phase:       postgresqlv1alpha1.DatabaseInitializationRunningPhase,
+		SourceKind:  databaseInitSourceKindDatabase,
+		Source:      sourceDB.Namespace + "/" + sourceDB.Name,
+		SourceOwner: sourceDB.Status.Roles.Owner,
+		SourceRoles: []string{sourceDB.Status.Roles.Reader, sourceDB.Status.Roles.Writer},
+	}
+
+	return nil
+}
+
+func (r *PostgresqlDatabaseReconciler) findInitBackup(
+	ctx context.Context,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+) (*postgresqlv1alpha1.PostgresqlBackup, error) {
+	link := instance.Spec.InitFrom.Backup
+
+	// Check namespace as restore job must have access to backup destination
+	if link.Namespace != "" && link.Namespace != instance.Namespace {
+		return nil, errors.NewBadRequest("init from backup must be in the same namespace")
+	}
+
+	// Get backup
+	backup := &postgresqlv1alpha1.PostgresqlBackup{}
+	err := r.Get(ctx, types.NamespacedName{Name: link.Name, Namespace: instance.Namespace}, backup)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that backup is succeeded
+	if backup.Status.Phase != postgresqlv1alpha1.BackupSucceededPhase {
+		return nil, errors.NewBadRequest(fmt.Sprintf("PostgresqlBackup %s/%s isn't succeeded", backup.Namespace, backup.Name))
+	}
+
+	return backup, nil
+}
+
+func (r *PostgresqlDatabaseReconciler) manageInitialization(
+	ctx context.Context,
+	pg postgres.PG,
+	pgEngCfg *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	owner, reader, writer string,
+) (bool, error) {
+	initStatus := instance.Status.Initialization
+
+	// Check if it has already failed
+	if initStatus.Phase == postgresqlv1alpha1.DatabaseInitializationFailedPhase {
+		return false, errors.NewBadRequest(
+			fmt.Sprintf("initialization from %s %s failed, database must be recreated", initStatus.SourceKind, initStatus.Source),
+		)
+	}
+
+	// Check if it is a restore
+	if initStatus.SourceKind == databaseInitSourceKindBackup {
+		done, err := r.manageRestoreJob(ctx, pg, pgEngCfg, instance, owner)
+		// Check error or not done
+		if err != nil || !done {
+			return false, err
+		}
+	} else {
+		// Objects owned by source owner must be owned by database owner
+		if initStatus.SourceOwner != "" && initStatus.SourceOwner != owner {
+			err := pg.ChangeOwnedObjectsOwner(ctx, instance.Spec.Database, initStatus.SourceOwner, owner)
+			// Check error
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// List schemas
+	schemas, err := pg.ListUserSchemas(ctx, instance.Spec.Database)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	// Loop over schemas to fix privileges
+	for _, schema := range schemas {
+		// Revoke source roles privileges
+		for _, role := range initStatus.SourceRoles {
+			// Check if role exists
+			exists, err := pg.IsRoleExist(ctx, role)
+			// Check error
+			if err != nil {
+				return false, err
+			}
+			// Ignore not existing roles
+			if !exists {
+				continue
+			}
+
+			err = pg.RevokeAllPrivilegesInSchema(ctx, instance.Spec.Database, schema, role)
+			// Check error
+			if err != nil {
+				return false, err
+			}
+		}
+
+		// Set privileges on schema
+		err = pg.SetSchemaPrivileges(ctx, instance.Spec.Database, owner, reader, schema, readerPrivs)
+		if err != nil {
+			return false, err
+		}
+
+		err = pg.SetSchemaPrivileges(ctx, instance.Spec.Database, owner, writer, schema, writerPrivs)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "DatabaseInitialized", "Database initialized from %s %s", initStatus.SourceKind, initStatus.Source)
+
+	// Update status
+	initStatus.Phase = postgresqlv1alpha1.DatabaseInitializationCompletedPhase
+
+	return true, nil
+}
+
+func (r *PostgresqlDatabaseReconciler) manageRestoreJob(
+	ctx context.Context,
+	pg postgres.PG,
+	pgEngCfg *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	owner string,
+) (bool, error) {
+	initStatus := instance.Status.Initialization
+
+	// Check if job must be created
+	if initStatus.JobName == "" {
+		// Find backup
+		backup, err := r.findInitBackup(ctx, instance)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		// Create job role and credentials secret
+		role, credSecret, err := manageJobRole(
+			ctx, r.Client, r.Scheme, pg, instance,
+			instance.Name+RestoreCredentialsSecretSuffix,
+			pgEngCfg, owner, instance.Spec.Database, initStatus.Role,
+		)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		// Save role
+		initStatus.Role = role
+
+		// Build job
+		job, err := newOwnedJob(r.Scheme, instance, instance.Name+RestoreJobSuffix, newRestorePodSpec(backup, credSecret.Name, owner))
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		// Create job
+		err = r.Create(ctx, job)
+		// Check error
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return false, err
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "RestoreStarted", "Restore job %s created", job.Name)
+
+		// Save status
+		initStatus.JobName = job.Name
+
+		return false, nil
+	}
+
+	// Get job
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: initStatus.JobName, Namespace: instance.Namespace}, job)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	// Check if job is finished
+	finished, succeeded := isJobFinished(job)
+	if !finished {
+		return false, nil
+	}
+
+	// Drop job role as it isn't needed anymore
+	// ? Note: Objects still owned by job role are given to database owner
+	if initStatus.Role != "" {
+		err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, initStatus.Role, owner, instance.Spec.Database)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		// Clean status
+		initStatus.Role = ""
+	}
+
+	// Check if it has failed
+	if !succeeded {
+		// Get termination message
+		msg, err := getJobContainerTerminationMessage(ctx, r.Client, job, restoreContainerName)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+
+		// Update status
+		initStatus.Phase = postgresqlv1alpha1.DatabaseInitializationFailedPhase
+
+		// Build message
+		errMsg := fmt.Sprintf("restore job %s failed", job.Name)
+		if msg != "" {
+			errMsg = fmt.Sprintf("%s: %s", errMsg, strings.TrimSpace(msg))
+		}
+
+		return false, errors.NewBadRequest(errMsg)
+	}
+
+	return true, nil
+}
+
 func (r *PostgresqlDatabaseReconciler) manageDropDatabase(
 	ctx context.Context,
 	logger logr.Logger,
 	instance *postgresqlv1alpha1.PostgresqlDatabase,
-) error {
+) (bool, error) {
 	// Try to find PostgresqlEngineConfiguration CR
 	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, instance)
 	if err != nil && !errors.IsNotFound(err) {
-		return err
+		return false, err
 	}
 	// In case of not found => Can't delete => skip
 	if errors.IsNotFound(err) {
 		logger.Error(err, "can't delete database because PostgresEngineConfiguration didn't exists anymore")
 
-		return nil
+		return true, nil
 	}
 
 	// Get secret linked to PostgresqlEngineConfiguration CR
 	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// Create PG instance
 	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
 
+	// Close saved pools for this database to ignore operator sessions
+	err = utils.CloseDatabaseSavedPoolsForName(instance, instance.Spec.Database)
+	if err != nil {
+		return false, err
+	}
+
+	// Check sessions still connected before dropping anything
+	done, err := r.manageDropDatabaseSessions(ctx, pg, instance)
+	// Check error or if it must wait
+	if err != nil || !done {
+		return done, err
+	}
+
+	// Drop restore job role if restore is still running
+	if instance.Status.Initialization != nil && instance.Status.Initialization.Role != "" {
+		err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.Initialization.Role, pg.GetUser(), instance.Spec.Database)
+		if err != nil {
+			return false, err
+		}
+		// Clear status
+		instance.Status.Initialization.Role = ""
+	}
+
+	// Check if database must be soft deleted
+	// ? Note: Roles are kept as they still own objects in soft deleted database
+	if instance.Spec.SoftDelete != nil {
+		exists, err := pg.IsDatabaseExist(ctx, instance.Spec.Database)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+		// Check if database exists
+		if exists {
+			return true, r.manageDBSoftDelete(ctx, pg, instance)
+		}
+	}
+
 	// Drop roles first
 
 	// Init variable
@@ -372,14 +810,14 @@ func (r *PostgresqlDatabaseReconciler) manageDropDatabase(
 		exists, err = pg.IsRoleExist(ctx, instance.Status.Roles.Owner)
 		// Check error
 		if err != nil {
-			return err
+			return false, err
 		}
 		// Check if role exists before trying to delete it
 		if exists {
 			// Delete
 			err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.Roles.Owner, pg.GetUser(), instance.Spec.Database)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 		// Clear status
@@ -390,14 +828,14 @@ func (r *PostgresqlDatabaseReconciler) manageDropDatabase(
 		exists, err = pg.IsRoleExist(ctx, instance.Status.Roles.Writer)
 		// Check error
 		if err != nil {
-			return err
+			return false, err
 		}
 		// Check if role exists before trying to delete it
 		if exists {
 			// Delete
 			err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.Roles.Writer, pg.GetUser(), instance.Spec.Database)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 		// Clear status
@@ -408,14 +846,14 @@ func (r *PostgresqlDatabaseReconciler) manageDropDatabase(
 		exists, err = pg.IsRoleExist(ctx, instance.Status.Roles.Reader)
 		// Check error
 		if err != nil {
-			return err
+			return false, err
 		}
 		// Check if role exists before trying to delete it
 		if exists {
 			// Delete
 			err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.Roles.Reader, pg.GetUser(), instance.Spec.Database)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 		// Clear status
@@ -426,28 +864,186 @@ func (r *PostgresqlDatabaseReconciler) manageDropDatabase(
 	// This is done twice in the sequence, but function is idempotent => not a problem and should be kept otherwise a pool can survive
 	err = utils.CloseDatabaseSavedPoolsForName(instance, instance.Spec.Database)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	exists, err = pg.IsDatabaseExist(ctx, instance.Spec.Database)
 	// Check error
 	if err != nil {
-		return err
+		return false, err
 	}
 	// Check if role exists before trying to delete it
 	if exists {
-		// Drop database
-		err = pg.DropDatabase(ctx, instance.Spec.Database)
+		// Check if drop must be forced
+		if instance.Spec.DropStrategy == postgresqlv1alpha1.DatabaseForceDropStrategy {
+			err = pg.ForceDropDatabase(ctx, instance.Spec.Database)
+		} else {
+			// Drop database
+			err = pg.DropDatabase(ctx, instance.Spec.Database)
+		}
 		// Check error
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
 	// Default
+	return true, nil
+}
+
+func (*PostgresqlDatabaseReconciler) manageDropDatabaseSessions(
+	ctx context.Context,
+	pg postgres.PG,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+) (bool, error) {
+	// Reset status
+	instance.Status.BlockingSessions = nil
+
+	// Force strategy will terminate sessions
+	if instance.Spec.DropStrategy == postgresqlv1alpha1.DatabaseForceDropStrategy {
+		return true, nil
+	}
+
+	// Get sessions
+	sessions, err := pg.GetDatabaseSessions(ctx, instance.Spec.Database)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	// Check if there isn't any session
+	if len(sessions) == 0 {
+		return true, nil
+	}
+
+	// Save sessions in status
+	for _, it := range sessions {
+		instance.Status.BlockingSessions = append(instance.Status.BlockingSessions, postgresqlv1alpha1.DatabaseBlockingSession{
+			Pid:             it.Pid,
+			Username:        it.Username,
+			ApplicationName: it.ApplicationName,
+			ClientAddr:      it.ClientAddr,
+		})
+	}
+
+	// Check if it must wait
+	if instance.Spec.DropStrategy == postgresqlv1alpha1.DatabaseWaitDropStrategy {
+		timeout := DefaultDatabaseDropWaitTimeout
+		// Check if timeout is set
+		if instance.Spec.DropWaitTimeout != "" {
+			timeout, err = time.ParseDuration(instance.Spec.DropWaitTimeout)
+			// Check error
+			if err != nil {
+				return false, errors.NewBadRequest("drop wait timeout must be a valid duration")
+			}
+		}
+
+		// Check if timeout isn't reached
+		if time.Since(instance.GetDeletionTimestamp().Time) < timeout {
+			return false, nil
+		}
+
+		return false, errors.NewBadRequest(
+			fmt.Sprintf("timeout reached while waiting for %d session(s) to be closed before dropping database", len(sessions)),
+		)
+	}
+
+	return false, errors.NewBadRequest(fmt.Sprintf("cannot drop database because %d session(s) are still connected", len(sessions)))
+}
+
+func (r *PostgresqlDatabaseReconciler) manageDBSoftDelete(
+	ctx context.Context,
+	pg postgres.PG,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+) error {
+	// Parse retention
+	retention, err := time.ParseDuration(instance.Spec.SoftDelete.Retention)
+	// Check error
+	if err != nil {
+		return errors.NewBadRequest("soft delete retention must be a valid duration")
+	}
+
+	now := time.Now().UTC()
+
+	tombstone := &postgres.DatabaseTombstone{
+		Name:      postgres.BuildDatabaseTombstoneName(instance.Spec.Database, now),
+		Database:  instance.Spec.Database,
+		DeletedAt: now,
+		DropAfter: now.Add(retention),
+		Owner:     instance.Status.Roles.Owner,
+	}
+
+	// Save roles to drop them with tombstone
+	for _, role := range []string{instance.Status.Roles.Owner, instance.Status.Roles.Reader, instance.Status.Roles.Writer} {
+		if role != "" {
+			tombstone.Roles = append(tombstone.Roles, role)
+		}
+	}
+
+	// Rename database to tombstone
+	err = pg.TombstoneDatabase(ctx, tombstone)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(
+		instance, "Normal", "DatabaseSoftDeleted",
+		"Database renamed to %s and will be dropped after %s", tombstone.Name, tombstone.DropAfter.Format(time.RFC3339),
+	)
+
 	return nil
 }
 
+func (r *PostgresqlDatabaseReconciler) manageDBTombstoneRestore(
+	ctx context.Context,
+	pg postgres.PG,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	owner string,
+) (bool, error) {
+	// List tombstones
+	tombstones, err := pg.ListDatabaseTombstones(ctx)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	// Find latest tombstone for this database
+	var tombstone *postgres.DatabaseTombstone
+
+	for _, it := range tombstones {
+		if it.Database == instance.Spec.Database && (tombstone == nil || it.DeletedAt.After(tombstone.DeletedAt)) {
+			tombstone = it
+		}
+	}
+
+	// Check if nothing was found
+	if tombstone == nil {
+		return false, nil
+	}
+
+	// Restore
+	err = pg.RestoreDatabaseTombstone(ctx, tombstone)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	// Objects owned by previous owner must be owned by database owner
+	// ? Note: Database owner itself is changed after
+	if tombstone.Owner != "" && tombstone.Owner != owner {
+		err = pg.ChangeOwnedObjectsOwner(ctx, instance.Spec.Database, tombstone.Owner, owner)
+		// Check error
+		if err != nil {
+			return false, err
+		}
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "DatabaseRestored", "Database restored from tombstone %s", tombstone.Name)
+
+	return true, nil
+}
+
 func (r *PostgresqlDatabaseReconciler) shouldDropDatabase(
 	ctx context.Context,
 	instance *postgresqlv1alpha1.PostgresqlDatabase,
@@ -688,22 +1284,116 @@ func (*PostgresqlDatabaseReconciler) manageExtensions(ctx context.Context, pg po
 		instance.Status.Extensions = newStatusExtensions
 	}
 
-	// List extensions
-	currentExtensionList, err := pg.ListExtensions(ctx, instance.Spec.Database)
-	if err != nil {
-		return err
-	}
+	// Reset versions status
+	instance.Status.ExtensionVersions = nil
 
-	// Manage extensions creation
+	// Manage extensions creation and update
 	for _, extension := range instance.Spec.Extensions.List {
+		// Get options
+		options := postgresqlv1alpha1.DatabaseExtensionOptions{Name: extension}
+
+		for _, it := range instance.Spec.Extensions.Options {
+			if it.Name == extension {
+				options = it
+
+				break
+			}
+		}
+
+		// Get available versions on engine
+		available, err := pg.GetAvailableExtension(ctx, instance.Spec.Database, extension)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Check if extension isn't available
+		if available == nil {
+			return fmt.Errorf("extension %s isn't available on engine", extension)
+		}
+		// Check if asked version isn't available
+		if options.Version != "" && !funk.ContainsString(available.Versions, options.Version) {
+			return fmt.Errorf(
+				"extension %s version %s isn't available on engine, available versions are: %s",
+				extension, options.Version, strings.Join(available.Versions, ", "),
+			)
+		}
+
+		// Get installed extension
+		installed, err := pg.GetExtension(ctx, instance.Spec.Database, extension)
+		// Check error
+		if err != nil {
+			return err
+		}
+
 		// Check if extension isn't already in database
-		if !funk.ContainsString(currentExtensionList, extension) {
+		if installed == nil {
 			// Execute create extension SQL statement
-			err := pg.CreateExtension(ctx, instance.Spec.Database, extension)
+			err = pg.CreateExtension(ctx, instance.Spec.Database, extension, options.Schema, options.Version, options.Cascade)
 			if err != nil {
 				return err
 			}
+		} else {
+			// Check if version must be updated
+			if options.Version != "" && installed.Version != options.Version {
+				// Check if update path exists
+				// ? Note: Downgrades aren't supported by most extensions
+				var hasPath bool
+
+				hasPath, err = pg.HasExtensionUpdatePath(ctx, instance.Spec.Database, extension, installed.Version, options.Version)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				if !hasPath {
+					return errors.NewBadRequest(fmt.Sprintf(
+						"extension %s cannot be updated from version %s to %s, no update path available",
+						extension, installed.Version, options.Version,
+					))
+				}
+
+				err = pg.UpdateExtension(ctx, instance.Spec.Database, extension, options.Version)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Check if schema must be changed
+			if options.Schema != "" && installed.Schema != options.Schema {
+				// Check if extension can be moved
+				if !installed.Relocatable {
+					return errors.NewBadRequest(fmt.Sprintf(
+						"extension %s isn't relocatable, it cannot be moved from schema %s to %s",
+						extension, installed.Schema, options.Schema,
+					))
+				}
+
+				err = pg.SetExtensionSchema(ctx, instance.Spec.Database, extension, options.Schema)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Reload installed extension to get current version
+		installed, err = pg.GetExtension(ctx, instance.Spec.Database, extension)
+		// Check error
+		if err != nil {
+			return err
 		}
+		// Check if extension isn't installed
+		if installed == nil {
+			return fmt.Errorf("extension %s not found after creation", extension)
+		}
+
+		// Save version status
+		instance.Status.ExtensionVersions = append(instance.Status.ExtensionVersions, postgresqlv1alpha1.DatabaseExtensionVersionStatus{
+			Name:              extension,
+			InstalledVersion:  installed.Version,
+			Schema:            installed.Schema,
+			DefaultVersion:    available.DefaultVersion,
+			AvailableVersions: available.Versions,
+		})
 
 		// Check if extension was added. Skip if already added
 		if !funk.ContainsString(instance.Status.Extensions, extension) {
@@ -902,6 +1592,64 @@ func (r *PostgresqlDatabaseReconciler) manageError(
 	return ctrl.Result{}, issue
 }
 
+func (r *PostgresqlDatabaseReconciler) manageDropWaiting(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Update status
+	instance.Status.Message = fmt.Sprintf(
+		"waiting for %d session(s) to be closed before dropping database", len(instance.Status.BlockingSessions),
+	)
+	instance.Status.Ready = false
+	instance.Status.Phase = postgresqlv1alpha1.DatabaseDeletingPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Database drop waiting for sessions to be closed")
+
+	return ctrl.Result{RequeueAfter: databaseDropWaitRequeueDelay}, nil
+}
+
+func (r *PostgresqlDatabaseReconciler) manageInitializing(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *postgresqlv1alpha1.PostgresqlDatabase,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = false
+	instance.Status.Phase = postgresqlv1alpha1.DatabaseInitializingPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Database initialization in progress")
+
+	return ctrl.Result{}, nil
+}
+
 func (r *PostgresqlDatabaseReconciler) manageSuccess(
 	ctx context.Context,
 	logger logr.Logger,
@@ -934,5 +1682,6 @@ func (r *PostgresqlDatabaseReconciler) manageSuccess(
 func (r *PostgresqlDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&postgresqlv1alpha1.PostgresqlDatabase{}).
+		Owns(&batchv1.Job{}).
 		Complete(r)
 }
@@ -5,14 +5,19 @@ import (
 	gerrors "errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var _ = Describe("PostgresqlDatabase tests", func() {
@@ -227,7 +232,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					DropOnOnDelete:    false,
 					DeleteWithCascade: false,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              make([]string, 0),
 					DropOnOnDelete:    false,
 					DeleteWithCascade: false,
@@ -390,6 +395,288 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 		Expect(stillExists).To(BeFalse())
 	})
 
+	It("should soft delete database on crd deletion and restore it on crd creation", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete: true,
+				SoftDelete:   &postgresqlv1alpha1.DatabaseSoftDelete{Retention: "1h"},
+			},
+		}
+
+		// First create CR
+		Expect(k8sClient.Create(ctx, it.DeepCopy())).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !item.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Add table owned by owner to database
+		tableName := "tt"
+		Expect(createTableInSchemaAsAdmin(pgPublicSchemaName, tableName)).To(Succeed())
+		Expect(rawSQLQuery(fmt.Sprintf(`ALTER TABLE %s.%s OWNER TO "%s"`, pgPublicSchemaName, tableName, item.Status.Roles.Owner))).To(Succeed())
+
+		// Then delete CR
+		Expect(k8sClient.Delete(ctx, item)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, &postgresqlv1alpha1.PostgresqlDatabase{})
+
+				if err == nil {
+					return errors.New("should be deleted but not deleted")
+				}
+
+				// Check if error isn't a not found error
+				if err != nil && !apimachineryErrors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB has been renamed to a tombstone
+		exists, err := isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		exists, err = isSQLDBTombstoneExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		// Check roles have been kept for restore
+		for _, role := range []string{item.Status.Roles.Owner, item.Status.Roles.Reader, item.Status.Roles.Writer} {
+			exists, err = isSQLRoleExists(role)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		}
+
+		// Recreate CR
+		Expect(k8sClient.Create(ctx, it.DeepCopy())).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !item.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB has been restored with its content
+		exists, err = isSQLDBTombstoneExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		owner, err := getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, tableName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owner).To(Equal(item.Status.Roles.Owner))
+	})
+
+	It("should keep connect grantees saved by a failed soft delete on restore", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete: true,
+				SoftDelete:   &postgresqlv1alpha1.DatabaseSoftDelete{Retention: "1h"},
+			},
+		}
+
+		// First create CR
+		Expect(k8sClient.Create(ctx, it.DeepCopy())).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !item.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Simulate a previous soft delete that failed after saving metadata and revoking connect
+		Expect(rawSQLQuery(fmt.Sprintf(
+			`COMMENT ON DATABASE "%s" IS '{"tombstoneOf":"%s","deletedAt":"2024-03-05T14:07:09Z","dropAfter":"2024-03-05T15:07:09Z","grantees":["PUBLIC"]}'`,
+			pgdbDBName, pgdbDBName,
+		))).To(Succeed())
+		Expect(rawSQLQuery(fmt.Sprintf(`REVOKE CONNECT ON DATABASE "%s" FROM PUBLIC`, pgdbDBName))).To(Succeed())
+
+		// Then delete CR
+		Expect(k8sClient.Delete(ctx, item)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				exists, err := isSQLDBTombstoneExists(pgdbDBName)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				if !exists {
+					return errors.New("tombstone doesn't exist")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Recreate CR
+		Expect(k8sClient.Create(ctx, it.DeepCopy())).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !item.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check connect have been granted back to public
+		res, err := rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT has_database_privilege('public', '%s', 'CONNECT')`, pgdbDBName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(BeTrue())
+	})
+
+	It("should fail with an invalid soft delete retention", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete: true,
+				SoftDelete:   &postgresqlv1alpha1.DatabaseSoftDelete{Retention: "one week"},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if it.Status.Phase != postgresqlv1alpha1.DatabaseFailedPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Expect(it.Status.Message).To(Equal("soft delete retention must be a valid duration"))
+	})
+
 	It("should keep database on crd deletion if DropOnDelete set to false", func() {
 		// Create pgec
 		prov, _ := setupPGEC("10s", false)
@@ -1318,7 +1605,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1}, // Should be available (-> SELECT * FROM pg_available_extensions)
 					DropOnOnDelete:    true,
 					DeleteWithCascade: true,
@@ -1383,7 +1670,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1}, // Should be available (-> SELECT * FROM pg_available_extensions)
 					DropOnOnDelete:    true,
 					DeleteWithCascade: true,
@@ -1480,7 +1767,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1, pgdbExtensionName2}, // Should be available (-> SELECT * FROM pg_available_extensions)
 					DropOnOnDelete:    true,
 					DeleteWithCascade: true,
@@ -1549,7 +1836,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1},
 					DropOnOnDelete:    true,
 					DeleteWithCascade: false,
@@ -1641,7 +1928,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1},
 					DropOnOnDelete:    true,
 					DeleteWithCascade: true,
@@ -1733,7 +2020,7 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 					Name:      prov.Name,
 					Namespace: prov.Namespace,
 				},
-				Extensions: postgresqlv1alpha1.DatabaseModulesList{
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
 					List:              []string{pgdbExtensionName1, pgdbExtensionName2},
 					DropOnOnDelete:    true,
 					DeleteWithCascade: false,
@@ -2429,4 +2716,1078 @@ var _ = Describe("PostgresqlDatabase tests", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(exists).To(BeFalse())
 	})
+
+	It("should be ok to clone a database from another one", func() {
+		// Create pgec
+		setupPGEC("10s", false)
+
+		// Create source pgdb
+		source := setupPGDB(false)
+
+		// Add table to source
+		tableName := "tt"
+		Expect(createTableInSchemaAsAdmin(pgPublicSchemaName, tableName)).To(Succeed())
+
+		// Create cloned pgdb
+		pgdb := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName2,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName2,
+				EngineConfiguration: &common.CRLink{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				},
+				InitFrom: &postgresqlv1alpha1.DatabaseInitSource{
+					Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+				},
+				DropOnDelete: true,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, pgdb)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName2,
+					Namespace: pgdbNamespace,
+				}, pgdb)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !pgdb.Status.Ready {
+					return gerrors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(pgdb.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseCreatedPhase))
+		Expect(pgdb.Status.Initialization).NotTo(BeNil())
+		Expect(pgdb.Status.Initialization.Phase).To(Equal(postgresqlv1alpha1.DatabaseInitializationCompletedPhase))
+		Expect(pgdb.Status.Initialization.SourceKind).To(Equal("PostgresqlDatabase"))
+		Expect(pgdb.Status.Initialization.Source).To(Equal(pgdbNamespace + "/" + pgdbName))
+		Expect(pgdb.Status.Initialization.SourceOwner).To(Equal(source.Status.Roles.Owner))
+
+		// Check table is cloned and owned by new owner
+		owner, err := getTableOwnerInSchema(pgdbDBName2, pgPublicSchemaName, tableName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+	})
+
+	It("shouldn't change system objects owner when changing objects owned by admin", func() {
+		// Create pgec
+		setupPGEC("10s", false)
+
+		// Create pgdb
+		pgdb := setupPGDB(false)
+
+		// Add table owned by admin
+		tableName := "tt"
+		Expect(createTableInSchemaAsAdmin(pgPublicSchemaName, tableName)).To(Succeed())
+
+		// Create PG instance with admin user
+		poolName := "change-owner-test"
+		pg := postgres.NewPG(
+			poolName, "localhost", postgresUser, postgresPassword, "sslmode=disable", "postgres", 5432,
+			postgresqlv1alpha1.NoProvider, logf.Log,
+		)
+
+		defer func() {
+			Expect(postgres.CloseAllSavedPoolsForName(poolName)).To(Succeed())
+		}()
+
+		// Change objects owned by admin
+		Expect(pg.ChangeOwnedObjectsOwner(ctx, pgdbDBName, postgresUser, pgdb.Status.Roles.Owner)).To(Succeed())
+
+		// Check table is owned by owner
+		owner, err := getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, tableName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+		// Check system schemas and their objects are still owned by admin
+		res, err := rawSQLQueryBoolInDB(fmt.Sprintf(
+			`SELECT NOT EXISTS (SELECT 1 FROM pg_namespace WHERE (nspname LIKE 'pg\_%%' OR nspname = 'information_schema') AND nspowner <> '%[1]s'::regrole)
+AND NOT EXISTS (SELECT 1 FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace WHERE n.nspname IN ('pg_catalog', 'information_schema') AND c.relowner <> '%[1]s'::regrole)`,
+			postgresUser,
+		))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(BeTrue())
+	})
+
+	It("should fail to initialize from a backup in another namespace", func() {
+		// Create pgec
+		setupPGEC("10s", false)
+
+		pgdb := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName2,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName2,
+				EngineConfiguration: &common.CRLink{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				},
+				InitFrom: &postgresqlv1alpha1.DatabaseInitSource{
+					Backup: &common.CRLink{Name: pgbackupName, Namespace: pgbackupNamespace},
+				},
+				DropOnDelete: true,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, pgdb)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName2,
+					Namespace: pgdbNamespace,
+				}, pgdb)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if pgdb.Status.Phase != postgresqlv1alpha1.DatabaseFailedPhase {
+					return gerrors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		Expect(pgdb.Status.Ready).To(BeFalse())
+		Expect(pgdb.Status.Message).To(Equal("init from backup must be in the same namespace"))
+
+		// Check DB hasn't been created
+		exists, err := isSQLDBExists(pgdbDBName2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should be ok to restore a database from a backup", func() {
+		// Create pgec
+		setupPGEC("10s", false)
+
+		// Create pgdb
+		setupPGDB(false)
+
+		// Create backup and force it as succeeded
+		backup := setupPGBackup(postgresqlv1alpha1.PostgresqlBackupSpec{
+			Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			Destination: &postgresqlv1alpha1.PostgresqlBackupDestination{
+				PersistentVolumeClaim: &postgresqlv1alpha1.PostgresqlBackupPVCDestination{ClaimName: pgbackupClaimName},
+			},
+		})
+
+		backupJob := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: backup.Status.JobName, Namespace: pgbackupNamespace}, backupJob)).To(Succeed())
+		backupJob.Status.Succeeded = 1
+		backupJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, backupJob)).To(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: pgbackupName, Namespace: pgbackupNamespace}, backup)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if backup.Status.Phase != postgresqlv1alpha1.BackupSucceededPhase {
+					return gerrors.New("backup hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Create restored pgdb in backup namespace
+		pgdb := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName2,
+				Namespace: pgbackupNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName2,
+				EngineConfiguration: &common.CRLink{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				},
+				InitFrom: &postgresqlv1alpha1.DatabaseInitSource{
+					Backup: &common.CRLink{Name: pgbackupName},
+				},
+				DropOnDelete: true,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, pgdb)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName2,
+					Namespace: pgbackupNamespace,
+				}, pgdb)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if pgdb.Status.Phase != postgresqlv1alpha1.DatabaseInitializingPhase {
+					return gerrors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(pgdb.Status.Ready).To(BeFalse())
+		Expect(pgdb.Status.Initialization.Phase).To(Equal(postgresqlv1alpha1.DatabaseInitializationRunningPhase))
+		Expect(pgdb.Status.Initialization.SourceKind).To(Equal("PostgresqlBackup"))
+		Expect(pgdb.Status.Initialization.JobName).To(Equal(pgdbName2 + RestoreJobSuffix))
+		Expect(strings.HasPrefix(pgdb.Status.Initialization.Role, JobRolePrefix)).To(BeTrue())
+
+		// Save job role
+		jobRole := pgdb.Status.Initialization.Role
+
+		// Check restore job
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pgdb.Status.Initialization.JobName, Namespace: pgbackupNamespace}, job)).To(Succeed())
+		Expect(job.OwnerReferences).To(HaveLen(1))
+		Expect(job.OwnerReferences[0].Name).To(Equal(pgdbName2))
+		Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "RESTORE_ROLE", Value: pgdb.Status.Roles.Owner}))
+
+		// Mark job as succeeded
+		job.Status.Succeeded = 1
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName2,
+					Namespace: pgbackupNamespace,
+				}, pgdb)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !pgdb.Status.Ready {
+					return gerrors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		Expect(pgdb.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseCreatedPhase))
+		Expect(pgdb.Status.Initialization.Phase).To(Equal(postgresqlv1alpha1.DatabaseInitializationCompletedPhase))
+		Expect(pgdb.Status.Initialization.Role).To(Equal(""))
+
+		// Check that job role is dropped
+		res, err := rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = '%s')`, jobRole))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(BeTrue())
+	})
+
+	It("should block deletion when deletion protection is enabled", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete:       true,
+				DeletionProtection: true,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !it.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Try to delete pgdb
+		Expect(k8sClient.Delete(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if it.Status.Phase != postgresqlv1alpha1.DatabaseFailedPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Expect(it.Status.Message).To(Equal(common.DeletionProtectionMessage))
+
+		// Check DB still exists
+		exists, err := isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		// Remove protection
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				it.Spec.DeletionProtection = false
+
+				return k8sClient.Update(ctx, it)
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, &postgresqlv1alpha1.PostgresqlDatabase{})
+
+				if err == nil {
+					return errors.New("should be deleted but not deleted")
+				}
+
+				// Check if error isn't a not found error
+				if err != nil && !apimachineryErrors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB doesn't exist anymore
+		exists, err = isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should fail to drop database with connected sessions and default drop strategy", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete: true,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !it.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Open a session on database
+		key, err := connectToDBAs(postgresUser, postgresPassword, pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Try to delete pgdb
+		Expect(k8sClient.Delete(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if it.Status.Phase != postgresqlv1alpha1.DatabaseFailedPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Expect(it.Status.Message).To(Equal("cannot drop database because 1 session(s) are still connected"))
+		Expect(it.Status.BlockingSessions).To(HaveLen(1))
+		Expect(it.Status.BlockingSessions[0].Username).To(Equal(postgresUser))
+
+		// Close session
+		Expect(disconnectConnFromKey(key)).To(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, &postgresqlv1alpha1.PostgresqlDatabase{})
+
+				if err == nil {
+					return errors.New("should be deleted but not deleted")
+				}
+
+				// Check if error isn't a not found error
+				if err != nil && !apimachineryErrors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB doesn't exist anymore
+		exists, err := isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should wait for sessions to be closed with wait drop strategy", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete:    true,
+				DropStrategy:    postgresqlv1alpha1.DatabaseWaitDropStrategy,
+				DropWaitTimeout: "1h",
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !it.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Open a session on database
+		key, err := connectToDBAs(postgresUser, postgresPassword, pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Try to delete pgdb
+		Expect(k8sClient.Delete(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if it.Status.Phase != postgresqlv1alpha1.DatabaseDeletingPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Expect(it.Status.Message).To(Equal("waiting for 1 session(s) to be closed before dropping database"))
+		Expect(it.Status.BlockingSessions).To(HaveLen(1))
+
+		// Close session
+		Expect(disconnectConnFromKey(key)).To(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, &postgresqlv1alpha1.PostgresqlDatabase{})
+
+				if err == nil {
+					return errors.New("should be deleted but not deleted")
+				}
+
+				// Check if error isn't a not found error
+				if err != nil && !apimachineryErrors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB doesn't exist anymore
+		exists, err := isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should terminate sessions with force drop strategy", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				DropOnDelete: true,
+				DropStrategy: postgresqlv1alpha1.DatabaseForceDropStrategy,
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, it)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !it.Status.Ready {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Open a session on database
+		key, err := connectToDBAs(postgresUser, postgresPassword, pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Try to delete pgdb
+		Expect(k8sClient.Delete(ctx, it)).Should(Succeed())
+
+		// Session is terminated by operator, so only close client side
+		defer func() {
+			_ = dbConns[key].db.Close()
+			delete(dbConns, key)
+		}()
+
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, &postgresqlv1alpha1.PostgresqlDatabase{})
+
+				if err == nil {
+					return errors.New("should be deleted but not deleted")
+				}
+
+				// Check if error isn't a not found error
+				if err != nil && !apimachineryErrors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check DB doesn't exist anymore
+		exists, err := isSQLDBExists(pgdbDBName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should be ok to declare 1 extension with version and schema", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				Schemas: postgresqlv1alpha1.DatabaseModulesList{
+					List: []string{pgdbSchemaName1},
+				},
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
+					List: []string{pgdbExtensionName1},
+					Options: []postgresqlv1alpha1.DatabaseExtensionOptions{
+						{Name: pgdbExtensionName1, Version: "1.1", Schema: pgdbSchemaName1},
+					},
+				},
+			},
+		}
+
+		// Create provider
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase == postgresqlv1alpha1.DatabaseNoPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(item.Status.Message).To(BeEmpty())
+		Expect(item.Status.Ready).To(BeTrue())
+		Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseCreatedPhase))
+		Expect(item.Status.Extensions).To(Equal([]string{pgdbExtensionName1}))
+		Expect(item.Status.ExtensionVersions).To(HaveLen(1))
+		Expect(item.Status.ExtensionVersions[0].Name).To(Equal(pgdbExtensionName1))
+		Expect(item.Status.ExtensionVersions[0].InstalledVersion).To(Equal("1.1"))
+		Expect(item.Status.ExtensionVersions[0].Schema).To(Equal(pgdbSchemaName1))
+		Expect(item.Status.ExtensionVersions[0].AvailableVersions).To(ContainElement("1.1"))
+		Expect(item.Status.ExtensionVersions[0].DefaultVersion).ToNot(BeEmpty())
+
+		// Check extension exists in sql db
+		exists, err := isSQLExtensionExists(pgdbExtensionName1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("should fail when extension version has no update path", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
+					List: []string{pgdbExtensionName1},
+					Options: []postgresqlv1alpha1.DatabaseExtensionOptions{
+						{Name: pgdbExtensionName1, Version: "1.1"},
+					},
+				},
+			},
+		}
+
+		// Create provider
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase == postgresqlv1alpha1.DatabaseNoPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		Expect(item.Status.Ready).To(BeTrue())
+
+		// Downgrade
+		item.Spec.Extensions.Options[0].Version = "1.0"
+		Expect(k8sClient.Update(ctx, item)).Should(Succeed())
+
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase != postgresqlv1alpha1.DatabaseFailedPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(item.Status.Ready).To(BeFalse())
+		Expect(item.Status.Message).To(ContainSubstring("extension uuid-ossp cannot be updated from version 1.1 to 1.0, no update path available"))
+	})
+
+	It("should fail when extension isn't available on engine", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
+					List: []string{"fake-extension"},
+				},
+			},
+		}
+
+		// Create provider
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase == postgresqlv1alpha1.DatabaseNoPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(item.Status.Ready).To(BeFalse())
+		Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseFailedPhase))
+		Expect(item.Status.Message).To(ContainSubstring("extension fake-extension isn't available on engine"))
+	})
+
+	It("should fail when extension version isn't available on engine", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
+					List: []string{pgdbExtensionName1},
+					Options: []postgresqlv1alpha1.DatabaseExtensionOptions{
+						{Name: pgdbExtensionName1, Version: "0.0.0"},
+					},
+				},
+			},
+		}
+
+		// Create provider
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase == postgresqlv1alpha1.DatabaseNoPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(item.Status.Ready).To(BeFalse())
+		Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseFailedPhase))
+		Expect(item.Status.Message).To(ContainSubstring("extension uuid-ossp version 0.0.0 isn't available on engine"))
+
+		// Check extension doesn't exist in sql db
+		exists, err := isSQLExtensionExists(pgdbExtensionName1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should fail when extension options aren't declared in extensions list", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Create pgdb
+		it := &postgresqlv1alpha1.PostgresqlDatabase{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      pgdbName,
+				Namespace: pgdbNamespace,
+			},
+			Spec: postgresqlv1alpha1.PostgresqlDatabaseSpec{
+				Database: pgdbDBName,
+				EngineConfiguration: &common.CRLink{
+					Name:      prov.Name,
+					Namespace: prov.Namespace,
+				},
+				Extensions: postgresqlv1alpha1.DatabaseExtensionsList{
+					Options: []postgresqlv1alpha1.DatabaseExtensionOptions{
+						{Name: pgdbExtensionName1},
+					},
+				},
+			},
+		}
+
+		// Create provider
+		Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+		item := &postgresqlv1alpha1.PostgresqlDatabase{}
+		// Get updated pgdb
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgdbName,
+					Namespace: pgdbNamespace,
+				}, item)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if item.Status.Phase == postgresqlv1alpha1.DatabaseNoPhase {
+					return errors.New("pgdb hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).
+			Should(Succeed())
+
+		// Checks
+		Expect(item.Status.Ready).To(BeFalse())
+		Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.DatabaseFailedPhase))
+		Expect(item.Status.Message).To(Equal("extension uuid-ossp has options but isn't declared in extensions list"))
+	})
 })
@@ -28,6 +28,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	"github.com/easymile/postgresql-operator/internal/controller/config"
 	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
@@ -134,6 +135,11 @@ func (r *PostgresqlEngineConfigurationReconciler) mainReconcile(
 ) (ctrl.Result, error) {
 	// Deletion case
 	if !instance.GetDeletionTimestamp().IsZero() {
+		// Check if deletion protection is enabled
+		if common.IsDeletionProtected(instance, instance.Spec.DeletionProtection) {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(common.DeletionProtectionMessage))
+		}
+
 		// Need to delete
 		// Check if wait linked resources deletion flag is enabled
 		if instance.Spec.WaitLinkedResourcesDeletion {
@@ -217,6 +223,16 @@ func (r *PostgresqlEngineConfigurationReconciler) mainReconcile(
 		return ctrl.Result{}, nil
 	}
 
+	// Check if default password policy is set
+	if instance.Spec.DefaultPasswordPolicy != nil {
+		// Validate it
+		err = utils.ValidatePasswordPolicy(instance.Spec.DefaultPasswordPolicy)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+	}
+
 	// Calculate hash for status (this time is to update it in status)
 	hash, err := utils.CalculateHash(instance.Spec)
 	if err != nil {
@@ -265,9 +281,125 @@ func (r *PostgresqlEngineConfigurationReconciler) mainReconcile(
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 	}
 
+	// Drop expired soft deleted databases
+	err = r.manageDatabaseTombstones(ctx, reqLogger, pg, instance)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
 	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
 }
 
+func (r *PostgresqlEngineConfigurationReconciler) manageDatabaseTombstones(
+	ctx context.Context,
+	logger logr.Logger,
+	pg postgres.PG,
+	instance *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+) error {
+	// List tombstones
+	tombstones, err := pg.ListDatabaseTombstones(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res := []postgresqlv1alpha1.DatabaseTombstone{}
+	dropped := []*postgres.DatabaseTombstone{}
+
+	// Loop over tombstones
+	for _, it := range tombstones {
+		// Check if retention is over
+		if now.After(it.DropAfter) {
+			// Drop database
+			err = pg.DropDatabase(ctx, it.Name)
+			// Check error
+			if err == nil {
+				r.Recorder.Eventf(instance, "Normal", "TombstoneDropped", "Soft deleted database %s dropped", it.Name)
+
+				// Save it to drop its roles
+				dropped = append(dropped, it)
+
+				continue
+			}
+
+			// ? Note: A failure mustn't put the engine in failure, tombstone will be dropped at next check
+			logger.Error(err, "unable to drop soft deleted database", "tombstone", it.Name)
+			r.Recorder.Eventf(instance, "Warning", "TombstoneDropFailed", "Soft deleted database %s cannot be dropped: %s", it.Name, err.Error())
+		}
+
+		// Save
+		res = append(res, postgresqlv1alpha1.DatabaseTombstone{
+			Name:      it.Name,
+			Database:  it.Database,
+			DeletedAt: it.DeletedAt.UTC().Format(time.RFC3339),
+			DropAfter: it.DropAfter.UTC().Format(time.RFC3339),
+		})
+	}
+
+	// Drop roles kept for restore of dropped tombstones
+	for _, it := range dropped {
+		r.dropDatabaseTombstoneRoles(ctx, logger, pg, instance, it, res)
+	}
+
+	// Save status
+	instance.Status.Tombstones = res
+
+	return nil
+}
+
+// dropDatabaseTombstoneRoles will drop roles kept for a tombstone restore.
+// Roles are kept when database have been recreated or when another tombstone of the same database still exists.
+func (r *PostgresqlEngineConfigurationReconciler) dropDatabaseTombstoneRoles(
+	ctx context.Context,
+	logger logr.Logger,
+	pg postgres.PG,
+	instance *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	tombstone *postgres.DatabaseTombstone,
+	tombstones []postgresqlv1alpha1.DatabaseTombstone,
+) {
+	// Check if there isn't any role
+	if len(tombstone.Roles) == 0 {
+		return
+	}
+
+	// Check if another tombstone still needs roles
+	for _, it := range tombstones {
+		if it.Database == tombstone.Database {
+			return
+		}
+	}
+
+	// Check if database have been recreated
+	exists, err := pg.IsDatabaseExist(ctx, tombstone.Database)
+	// Check error
+	if err != nil {
+		// ? Note: A failure mustn't put the engine in failure, roles will be kept
+		logger.Error(err, "unable to check database existence for tombstone roles", "tombstone", tombstone.Name)
+
+		return
+	}
+	// Check if it exists
+	if exists {
+		return
+	}
+
+	// Loop over roles
+	for _, role := range tombstone.Roles {
+		// Drop role
+		err = pg.DropRole(ctx, role)
+		// Check error
+		if err != nil {
+			// ? Note: A failure mustn't put the engine in failure, role can still be used somewhere else
+			logger.Error(err, "unable to drop soft deleted database role", "tombstone", tombstone.Name, "role", role)
+			r.Recorder.Eventf(
+				instance, "Warning", "TombstoneRoleDropFailed",
+				"Role %s of soft deleted database %s cannot be dropped: %s", role, tombstone.Name, err.Error(),
+			)
+		}
+	}
+}
+
 func (r *PostgresqlEngineConfigurationReconciler) getAnyDatabaseLinked(
 	ctx context.Context,
 	instance *postgresqlv1alpha1.PostgresqlEngineConfiguration,
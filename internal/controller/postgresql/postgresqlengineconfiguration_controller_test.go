@@ -4,7 +4,9 @@ import (
 	"errors"
 	gerrors "errors"
 	"fmt"
+	"time"
 
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1023,4 +1025,124 @@ var _ = Describe("PostgresqlEngineConfiguration tests", func() {
 			generalEventuallyInterval,
 		).Should(Succeed())
 	})
+
+	It("should drop expired database tombstones", func() {
+		now := time.Now()
+
+		// Create tombstones roles
+		expiredRole := pgdbDBName + "-owner"
+		keptRole := pgdbDBName2 + "-owner"
+		Expect(createSQLRole(expiredRole)).To(Succeed())
+		Expect(createSQLRole(keptRole)).To(Succeed())
+
+		// Create tombstones
+		expired, err := createSQLDBTombstone(pgdbDBName, now.Add(-2*time.Hour), now.Add(-time.Hour), expiredRole)
+		Expect(err).ToNot(HaveOccurred())
+		kept, err := createSQLDBTombstone(pgdbDBName2, now.Add(-time.Hour), now.Add(time.Hour), keptRole)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create pgec
+		setupPGEC("10s", false)
+
+		pgec := &postgresqlv1alpha1.PostgresqlEngineConfiguration{}
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				}, pgec)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if !pgec.Status.Ready {
+					return errors.New("pgec hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Check status
+		Expect(pgec.Status.Tombstones).To(HaveLen(1))
+		Expect(pgec.Status.Tombstones[0].Name).To(Equal(kept))
+		Expect(pgec.Status.Tombstones[0].Database).To(Equal(pgdbDBName2))
+
+		// Check databases
+		exists, err := isSQLDBExists(expired)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		exists, err = isSQLDBExists(kept)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		// Check roles
+		exists, err = isSQLRoleExists(expiredRole)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		exists, err = isSQLRoleExists(keptRole)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("should block deletion when deletion protection annotation is set", func() {
+		// Create pgec
+		prov, _ := setupPGEC("10s", false)
+
+		// Add annotation
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				}, prov)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				prov.Annotations = map[string]string{common.DeletionProtectionAnnotation: "true"}
+
+				return k8sClient.Update(ctx, prov)
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		// Try to delete pgec
+		Expect(k8sClient.Delete(ctx, prov)).Should(Succeed())
+
+		pgec := &postgresqlv1alpha1.PostgresqlEngineConfiguration{}
+		Eventually(
+			func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      pgecName,
+					Namespace: pgecNamespace,
+				}, pgec)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				// Check if status hasn't been updated
+				if pgec.Status.Phase != postgresqlv1alpha1.EngineFailedPhase {
+					return errors.New("pgec hasn't been updated by operator")
+				}
+
+				return nil
+			},
+			generalEventuallyTimeout,
+			generalEventuallyInterval,
+		).Should(Succeed())
+
+		Expect(pgec.Status.Ready).To(BeFalse())
+		Expect(pgec.Status.Message).To(Equal(common.DeletionProtectionMessage))
+		Expect(pgec.DeletionTimestamp.IsZero()).To(BeFalse())
+	})
 })
@@ -0,0 +1,688 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultMigrationTrackingTableName = "postgresql_operator_migrations"
+	MigrationFileSuffix               = ".sql"
+	MigrationRolePrefix               = "pgmigration-"
+	migrationRoleCleanupTimeout       = 30 * time.Second
+)
+
+type migrationFile struct {
+	Version  string
+	Checksum string
+	Content  string
+}
+
+// PostgresqlMigrationReconciler reconciles a PostgresqlMigration object.
+type PostgresqlMigrationReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlmigrations,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlmigrations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlmigrations/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlMigration object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlMigration")
+
+	// Fetch the PostgresqlMigration instance
+	instance := &v1alpha1.PostgresqlMigration{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlMigrationReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlMigration,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// ? Note: No deletion case as migrations are never rolled back
+
+	// Validate
+	err := r.validate(instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres database is ready before continue
+	// ? Note: Migrations must always be run on a ready database with created roles
+	if !pgDB.Status.Ready {
+		reqLogger.Info("PostgresqlDatabase not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlDatabase isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres engine configuration is ready before continue but only if it is the first time
+	// If not, requeue event
+	if instance.Status.Phase == v1alpha1.MigrationNoPhase && !pgEngCfg.Status.Ready {
+		reqLogger.Info("PostgresqlEngineConfiguration not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Add default values
+	updated, err := r.updateInstance(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if it has been updated in order to stop this reconcile loop here for the moment
+	if updated {
+		return ctrl.Result{}, nil
+	}
+
+	// Get migration files
+	files, err := r.getMigrationFiles(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg)
+
+	// Save data for easy use
+	spec := instance.Spec
+	owner := pgDB.Status.Roles.Owner
+
+	// Drop temporary role left by a previous reconcile
+	err = r.dropTemporaryRole(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Create tracking table
+	err = pg.CreateMigrationTrackingTable(ctx, pgDB.Status.Database, spec.TrackingTableSchema, spec.TrackingTableName, owner)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Get applied migrations
+	applied, err := pg.GetAppliedMigrations(ctx, pgDB.Status.Database, spec.TrackingTableSchema, spec.TrackingTableName)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that already applied migrations haven't been edited
+	for _, it := range applied {
+		// Find file
+		f, found := lo.Find(files, func(f *migrationFile) bool { return f.Version == it.Version })
+		// Check if checksum is different
+		if found && f.Checksum != it.Checksum {
+			return r.manageError(
+				ctx, reqLogger, instance, originalPatch,
+				errors.NewBadRequest(fmt.Sprintf("migration %s have been edited after being applied, this isn't allowed", it.Version)),
+			)
+		}
+	}
+
+	// Get pending migrations
+	pending := lo.Filter(files, func(f *migrationFile, _ int) bool {
+		return !lo.ContainsBy(applied, func(it *postgres.AppliedMigration) bool { return it.Version == f.Version })
+	})
+
+	// Check if there are pending migrations
+	if len(pending) != 0 {
+		// Apply pending migrations
+		err = r.applyMigrations(ctx, reqLogger, instance, originalPatch, pg, pgEngCfg, pgDB, pending)
+		// Check error
+		if err != nil {
+			// Save already applied migrations before failing
+			// ? Note: Error is ignored here as migration error is the one to report
+			_ = r.saveAppliedVersionsInStatus(ctx, instance, pg, pgDB)
+
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+	}
+
+	// Save applied migrations
+	err = r.saveAppliedVersionsInStatus(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+}
+
+// applyMigrations will apply migrations in order with a temporary login role member of the database owner role.
+// ? Note: Migrations mustn't be run with the engine configuration user because their content can reset role.
+func (r *PostgresqlMigrationReconciler) applyMigrations(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlMigration,
+	originalPatch client.Patch,
+	pg postgres.PG,
+	pgEngCfg *v1alpha1.PostgresqlEngineConfiguration,
+	pgDB *v1alpha1.PostgresqlDatabase,
+	files []*migrationFile,
+) error {
+	// Save data for easy use
+	spec := instance.Spec
+	owner := pgDB.Status.Roles.Owner
+	database := pgDB.Status.Database
+
+	// Create temporary role
+	role, login, password, err := utils.CreateMemberLoginRole(ctx, pg, MigrationRolePrefix, owner)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Save temporary role in status to drop it on next reconcile if cleanup fails
+	instance.Status.TemporaryRole = role
+
+	// Create PG instance for temporary role
+	migrationPG := utils.CreatePgInstanceForLogin(logger, pgEngCfg, login, password, database)
+
+	defer func() {
+		// Close temporary role connections
+		err := utils.CloseSavedPoolsForLogin(pgEngCfg, login)
+		// Check error
+		if err != nil {
+			logger.Error(err, "unable to close migration role connections")
+		}
+
+		// Use a dedicated context as reconcile one can be canceled or expired
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), migrationRoleCleanupTimeout)
+		// Defer cancel
+		defer cancel()
+
+		// Give objects created by temporary role to owner and drop it
+		err = pg.DropRoleAndDropAndChangeOwnedBy(cleanupCtx, role, owner, database)
+		// Check error
+		if err != nil {
+			logger.Error(err, "unable to drop migration role", "role", role)
+
+			return
+		}
+
+		// Clean status
+		instance.Status.TemporaryRole = ""
+	}()
+
+	// Patch status
+	err = r.Status().Patch(ctx, instance, originalPatch)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Loop over files to apply migrations in order
+	for _, f := range files {
+		logger.Info("Applying migration", "version", f.Version)
+
+		// Apply migration
+		err = migrationPG.ApplyMigration(ctx, database, spec.TrackingTableSchema, spec.TrackingTableName, owner, f.Version, f.Checksum, f.Content)
+		// Check error
+		if err != nil {
+			return errors.NewBadRequest(fmt.Sprintf("migration %s failed: %s", f.Version, err.Error()))
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "MigrationApplied", "Migration %s applied", f.Version)
+	}
+
+	return nil
+}
+
+// dropTemporaryRole will drop temporary role saved in status that a previous reconcile couldn't drop.
+func (*PostgresqlMigrationReconciler) dropTemporaryRole(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlMigration,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Check if there isn't any temporary role
+	if instance.Status.TemporaryRole == "" {
+		return nil
+	}
+
+	// Check if role exists
+	exists, err := pg.IsRoleExist(ctx, instance.Status.TemporaryRole)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if role exists
+	if exists {
+		// Give objects created by temporary role to owner and drop it
+		err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.TemporaryRole, pgDB.Status.Roles.Owner, pgDB.Status.Database)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Clean status
+	instance.Status.TemporaryRole = ""
+
+	return nil
+}
+
+func (*PostgresqlMigrationReconciler) saveAppliedVersionsInStatus(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlMigration,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Get applied migrations
+	applied, err := pg.GetAppliedMigrations(ctx, pgDB.Status.Database, instance.Spec.TrackingTableSchema, instance.Spec.TrackingTableName)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Sort by version
+	// ? Note: Tracking table order is a lexical one
+	sort.Slice(applied, func(i, j int) bool { return isMigrationVersionBefore(applied[i].Version, applied[j].Version) })
+
+	// Save
+	instance.Status.AppliedVersions = lo.Map(applied, func(it *postgres.AppliedMigration, _ int) *v1alpha1.PostgresqlMigrationAppliedVersion {
+		return &v1alpha1.PostgresqlMigrationAppliedVersion{
+			Version:   it.Version,
+			Checksum:  it.Checksum,
+			AppliedAt: it.AppliedAt.UTC().Format(time.RFC3339),
+		}
+	})
+
+	// Check if there is at least one applied migration
+	if len(applied) != 0 {
+		instance.Status.LastAppliedVersion = applied[len(applied)-1].Version
+	}
+
+	return nil
+}
+
+func (r *PostgresqlMigrationReconciler) getMigrationFiles(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlMigration,
+) ([]*migrationFile, error) {
+	// Init data
+	data := map[string]string{}
+
+	// Check if it is a config map
+	if instance.Spec.ConfigMapName != "" {
+		cm, err := utils.GetConfigMap(ctx, r.Client, instance.Spec.ConfigMapName, instance.Namespace)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		data = cm.Data
+	} else {
+		sec, err := utils.GetSecret(ctx, r.Client, instance.Spec.SecretName, instance.Namespace)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range sec.Data {
+			data[k] = string(v)
+		}
+	}
+
+	res := []*migrationFile{}
+
+	// Loop over data
+	for k, v := range data {
+		// Ignore non sql files
+		if !strings.HasSuffix(k, MigrationFileSuffix) {
+			continue
+		}
+
+		// Compute checksum
+		sum := sha256.Sum256([]byte(v))
+
+		res = append(res, &migrationFile{
+			Version:  strings.TrimSuffix(k, MigrationFileSuffix),
+			Checksum: hex.EncodeToString(sum[:]),
+			Content:  v,
+		})
+	}
+
+	// Sort by version
+	sort.Slice(res, func(i, j int) bool { return isMigrationVersionBefore(res[i].Version, res[j].Version) })
+
+	return res, nil
+}
+
+// isMigrationVersionBefore will return true if version a must be applied before version b.
+// Digit sequences are compared numerically (e.g: "9_init" is before "10_update") and other parts lexically.
+func isMigrationVersionBefore(a, b string) bool {
+	// Save original values
+	oa, ob := a, b
+
+	for a != "" && b != "" {
+		// Get next parts
+		pa, pb := nextMigrationVersionPart(a), nextMigrationVersionPart(b)
+		a, b = a[len(pa):], b[len(pb):]
+
+		// Check if parts are both numbers
+		if isMigrationVersionDigit(pa[0]) && isMigrationVersionDigit(pb[0]) {
+			// Remove leading zeros
+			na, nb := strings.TrimLeft(pa, "0"), strings.TrimLeft(pb, "0")
+			// Longer number is greater
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			// Same length numbers can be compared lexically
+			if na != nb {
+				return na < nb
+			}
+
+			continue
+		}
+
+		// Compare lexically
+		if pa != pb {
+			return pa < pb
+		}
+	}
+
+	// Check if one is a prefix of the other one
+	if a != b {
+		return a == ""
+	}
+
+	// Same versions with different leading zeros
+	return oa < ob
+}
+
+// nextMigrationVersionPart will return the first part of version: a digits sequence or a non digits one.
+func nextMigrationVersionPart(v string) string {
+	digit := isMigrationVersionDigit(v[0])
+
+	i := 1
+	for i < len(v) && isMigrationVersionDigit(v[i]) == digit {
+		i++
+	}
+
+	return v[:i]
+}
+
+func isMigrationVersionDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (*PostgresqlMigrationReconciler) validate(
+	instance *v1alpha1.PostgresqlMigration,
+) error {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Check that a source is set
+	if spec.ConfigMapName == "" && spec.SecretName == "" {
+		return errors.NewBadRequest("config map name or secret name must be set")
+	}
+
+	// Check that only one source is set
+	if spec.ConfigMapName != "" && spec.SecretName != "" {
+		return errors.NewBadRequest("config map name and secret name cannot be set together")
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlMigrationReconciler) updateInstance(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlMigration,
+) (bool, error) {
+	// Deep copy
+	oCopy := instance.DeepCopy()
+
+	// Check if tracking table schema isn't set
+	if instance.Spec.TrackingTableSchema == "" {
+		// Set to default
+		instance.Spec.TrackingTableSchema = defaultPGPublicSchemaName
+	}
+
+	// Check if tracking table name isn't set
+	if instance.Spec.TrackingTableName == "" {
+		// Set to default
+		instance.Spec.TrackingTableName = DefaultMigrationTrackingTableName
+	}
+
+	// Check if update is needed
+	if !reflect.DeepEqual(oCopy.Spec, instance.Spec) {
+		return true, r.Update(ctx, instance)
+	}
+
+	return false, nil
+}
+
+func (r *PostgresqlMigrationReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlMigration,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.MigrationFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlMigrationReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlMigration,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = v1alpha1.MigrationAppliedPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return reconcile.Result{}, nil
+}
+
+// findMigrationsForSource will return requests for migrations using the given config map or secret as source.
+func (r *PostgresqlMigrationReconciler) findMigrationsForSource(ctx context.Context, obj client.Object) []reconcile.Request {
+	// List migrations in the same namespace
+	list := &v1alpha1.PostgresqlMigrationList{}
+	err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()))
+	// Check error
+	if err != nil {
+		r.Log.Error(err, "unable to list migrations")
+
+		return nil
+	}
+
+	// Check if it is a config map
+	_, isConfigMap := obj.(*corev1.ConfigMap)
+
+	res := []reconcile.Request{}
+	// Loop over migrations
+	for _, it := range list.Items {
+		// Get source name
+		name := it.Spec.SecretName
+		if isConfigMap {
+			name = it.Spec.ConfigMapName
+		}
+
+		// Check if it is the source
+		if name == obj.GetName() {
+			res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{Name: it.Name, Namespace: it.Namespace}})
+		}
+	}
+
+	return res
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PostgresqlMigration{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.findMigrationsForSource)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findMigrationsForSource)).
+		Complete(r)
+}
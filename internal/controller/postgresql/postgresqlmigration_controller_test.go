@@ -0,0 +1,380 @@
+package postgresql
+
+import (
+	gerrors "errors"
+	"fmt"
+	"strings"
+
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("PostgresqlMigration tests", func() {
+	AfterEach(cleanupFunction)
+
+	Describe("Spec error", func() {
+		It("shouldn't accept input without any specs", func() {
+			err := k8sClient.Create(ctx, &postgresqlv1alpha1.PostgresqlMigration{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgmigrationName,
+					Namespace: pgmigrationNamespace,
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+
+			// Cast error
+			stErr, ok := err.(*apimachineryErrors.StatusError)
+
+			Expect(ok).To(BeTrue())
+
+			// Check that content is correct
+			causes := stErr.Status().Details.Causes
+
+			Expect(causes).To(HaveLen(1))
+
+			// Search all fields
+			fields := map[string]bool{
+				"spec.database": false,
+			}
+
+			// Loop over all causes
+			for _, cause := range causes {
+				fields[cause.Field] = true
+			}
+
+			// Check that all fields are found
+			for key, value := range fields {
+				if !value {
+					err := fmt.Errorf("%s found be found in error causes", key)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+
+		It("should fail when no source is provided", func() {
+			item := setupPGMigration("", "")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationFailedPhase))
+			Expect(item.Status.Message).To(Equal("config map name or secret name must be set"))
+		})
+
+		It("should fail when config map and secret are provided", func() {
+			item := setupPGMigration(pgmigrationSourceName, pgmigrationSourceName)
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationFailedPhase))
+			Expect(item.Status.Message).To(Equal("config map name and secret name cannot be set together"))
+		})
+	})
+
+	Describe("Apply", func() {
+		It("should be ok to apply migrations from a config map as owner", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			// Create config map
+			setupPGMigrationConfigMap(map[string]string{
+				"002_seed.sql":     "INSERT INTO customers (name) VALUES ('first');",
+				"001_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+				"README.md":        "Not a migration",
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationAppliedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.LastAppliedVersion).To(Equal("002_seed"))
+			Expect(item.Status.AppliedVersions).To(HaveLen(2))
+			Expect(item.Status.AppliedVersions[0].Version).To(Equal("001_baseline"))
+			Expect(item.Status.AppliedVersions[0].Checksum).NotTo(Equal(""))
+			Expect(item.Status.AppliedVersions[0].AppliedAt).NotTo(Equal(""))
+			Expect(item.Status.AppliedVersions[1].Version).To(Equal("002_seed"))
+			Expect(item.Spec.TrackingTableSchema).To(Equal(pgPublicSchemaName))
+			Expect(item.Spec.TrackingTableName).To(Equal(DefaultMigrationTrackingTableName))
+
+			// Check owner
+			owner, err := getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, "customers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+			owner, err = getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, DefaultMigrationTrackingTableName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+			// Check data
+			res, err := rawSQLQueryBoolInDB(`SELECT count(*) = 1 FROM customers`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+
+			res, err = rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT count(*) = 2 FROM public.%s`, DefaultMigrationTrackingTableName))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+
+			// Check temporary role have been dropped
+			Expect(item.Status.TemporaryRole).To(Equal(""))
+
+			res, err = rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT count(*) = 0 FROM pg_roles WHERE rolname LIKE '%s%%'`, MigrationRolePrefix))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should drop a temporary role left by a previous reconcile", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create config map
+			setupPGMigrationConfigMap(map[string]string{
+				"001_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			Expect(item.Status.Ready).To(BeTrue())
+
+			// Simulate a temporary role that couldn't be dropped
+			role := MigrationRolePrefix + "leftover"
+			Expect(createSQLRole(role)).To(Succeed())
+
+			item.Status.TemporaryRole = role
+			Expect(k8sClient.Status().Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					exists, err := isSQLRoleExists(role)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if exists {
+						return gerrors.New("temporary role still exists")
+					}
+
+					err = k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if item.Status.TemporaryRole != "" {
+						return gerrors.New("temporary role still in status")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+		})
+
+		It("should apply migrations in numeric version order", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create config map
+			setupPGMigrationConfigMap(map[string]string{
+				"10_seed.sql":    "INSERT INTO customers (name) VALUES ('first');",
+				"9_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationAppliedPhase))
+			Expect(item.Status.LastAppliedVersion).To(Equal("10_seed"))
+			Expect(item.Status.AppliedVersions).To(HaveLen(2))
+			Expect(item.Status.AppliedVersions[0].Version).To(Equal("9_baseline"))
+			Expect(item.Status.AppliedVersions[1].Version).To(Equal("10_seed"))
+		})
+
+		It("shouldn't run migrations with the engine user", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			// Create config map
+			setupPGMigrationConfigMap(map[string]string{
+				"001_reset.sql": "RESET ROLE; CREATE TABLE customers (id bigserial PRIMARY KEY);",
+				"002_admin.sql": fmt.Sprintf(`SET ROLE "%s"; CREATE TABLE admins (id bigserial PRIMARY KEY);`, postgresUser),
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationFailedPhase))
+			Expect(strings.HasPrefix(item.Status.Message, "migration 002_admin failed: ")).To(BeTrue())
+			Expect(item.Status.LastAppliedVersion).To(Equal("001_reset"))
+
+			// Objects created after a reset role must be owned by owner
+			owner, err := getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, "customers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+			// Check that table isn't created and temporary role is dropped
+			res, err := rawSQLQueryBoolInDB(
+				fmt.Sprintf(`SELECT to_regclass('public.admins') IS NULL AND NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname LIKE '%s%%')`, MigrationRolePrefix),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should be ok to apply migrations from a secret", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create secret
+			setupPGMigrationSecret(map[string]string{
+				"001_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+			})
+
+			// Setup migration
+			item := setupPGMigration("", pgmigrationSourceName)
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationAppliedPhase))
+			Expect(item.Status.LastAppliedVersion).To(Equal("001_baseline"))
+			Expect(item.Status.AppliedVersions).To(HaveLen(1))
+		})
+
+		It("should rollback a failing migration and stop", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create config map
+			setupPGMigrationConfigMap(map[string]string{
+				"001_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+				"002_bad.sql":      "CREATE TABLE orders (id bigserial PRIMARY KEY); SELECT * FROM not_found;",
+				"003_other.sql":    "CREATE TABLE others (id bigserial PRIMARY KEY);",
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationFailedPhase))
+			Expect(strings.HasPrefix(item.Status.Message, "migration 002_bad failed: ")).To(BeTrue())
+			Expect(item.Status.LastAppliedVersion).To(Equal("001_baseline"))
+			Expect(item.Status.AppliedVersions).To(HaveLen(1))
+
+			// Check that failing migration have been rolled back and next one isn't applied
+			res, err := rawSQLQueryBoolInDB(`SELECT to_regclass('public.orders') IS NULL AND to_regclass('public.others') IS NULL`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should be ok to apply a new migration and refuse an edited one", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create config map
+			cm := setupPGMigrationConfigMap(map[string]string{
+				"001_baseline.sql": "CREATE TABLE customers (id bigserial PRIMARY KEY, name text NOT NULL);",
+			})
+
+			// Setup migration
+			item := setupPGMigration(pgmigrationSourceName, "")
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationAppliedPhase))
+
+			// Add a new migration
+			cm.Data["002_orders.sql"] = "CREATE TABLE orders (id bigserial PRIMARY KEY);"
+			Expect(k8sClient.Update(ctx, cm)).To(Succeed())
+
+			updatedItem := &postgresqlv1alpha1.PostgresqlMigration{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.LastAppliedVersion != "002_orders" {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(updatedItem.Status.Phase).To(Equal(postgresqlv1alpha1.MigrationAppliedPhase))
+			Expect(updatedItem.Status.AppliedVersions).To(HaveLen(2))
+
+			// Edit an applied migration
+			updatedCm := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: cm.Namespace}, updatedCm)).To(Succeed())
+			updatedCm.Data["001_baseline.sql"] = "CREATE TABLE customers (id bigserial PRIMARY KEY);"
+			Expect(k8sClient.Update(ctx, updatedCm)).To(Succeed())
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.Phase != postgresqlv1alpha1.MigrationFailedPhase {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(updatedItem.Status.Ready).To(BeFalse())
+			Expect(updatedItem.Status.Message).To(Equal("migration 001_baseline have been edited after being applied, this isn't allowed"))
+		})
+	})
+})
@@ -19,18 +19,27 @@ package postgresql
 import (
 	"context"
 	"fmt"
+	"path"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	"github.com/easymile/postgresql-operator/internal/controller/config"
 	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
@@ -40,7 +49,13 @@ import (
 	"github.com/samber/lo"
 )
 
-const DefaultReplicationSlotPlugin = "pgoutput"
+const (
+	DefaultReplicationSlotPlugin       = "pgoutput"
+	DefaultSlotMonitoringCheckInterval = time.Minute
+	maxSlotSafeguardActions            = 10
+	DefaultCDCReplicationUserSuffix    = "-cdc"
+	cdcReplicationUserPrivilege        = "SELECT"
+)
 
 // PostgresqlPublicationReconciler reconciles a PostgresqlPublication object.
 type PostgresqlPublicationReconciler struct {
@@ -48,6 +63,9 @@ type PostgresqlPublicationReconciler struct {
 	client.Client
 	Scheme                              *runtime.Scheme
 	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	ReplicationSlotActive               *prometheus.GaugeVec
+	ReplicationSlotRetainedWALBytes     *prometheus.GaugeVec
+	ReplicationSlotConfirmedFlushLag    *prometheus.GaugeVec
 	Log                                 logr.Logger
 	ControllerName                      string
 	ReconcileTimeout                    time.Duration
@@ -56,6 +74,7 @@ type PostgresqlPublicationReconciler struct {
 //+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlpublications,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlpublications/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlpublications/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -135,6 +154,11 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 	if !instance.GetDeletionTimestamp().IsZero() { //nolint:wsl
 		// Deletion detected
 
+		// Check if deletion protection is enabled
+		if common.IsDeletionProtected(instance, instance.Spec.DeletionProtection) {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest(common.DeletionProtectionMessage))
+		}
+
 		// Check if drop on delete is enabled
 		if instance.Spec.DropOnDelete {
 			// Delete publication
@@ -144,6 +168,9 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 			}
 		}
 
+		// Clean replication slot metrics
+		r.deleteReplicationSlotMetrics(instance)
+
 		// Remove finalizer
 		controllerutil.RemoveFinalizer(instance, config.Finalizer)
 
@@ -223,6 +250,41 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 	// Create PG instance
 	pg := utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg)
 
+	// Check server support for publication options
+	err = r.checkServerSupport(ctx, instance, pg)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Compute publication owner
+	owner, err := r.getPublicationOwner(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Manage CDC tables before publication as they can be published
+	err = r.manageCDCTables(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Manage replica identities
+	err = r.manageReplicaIdentities(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Compute published tables with discovered ones
+	publishedTables, discoveredTables, err := r.computePublishedTables(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
 	// Compute name to search
 	nameToSearch := instance.Status.Name
 	// Check
@@ -243,7 +305,7 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 		// Create case
 		reqLogger.Info("Publication creation case detected")
 
-		err = r.manageCreate(ctx, instance, pg, pgDB)
+		err = r.manageCreate(ctx, instance, pg, pgDB, publishedTables, owner)
 		// Check error
 		if err != nil {
 			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -254,14 +316,14 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 		if hash != instance.Status.Hash {
 			reqLogger.Info("Specs are different, update need to be done")
 
-			err = r.manageUpdate(ctx, instance, pg, pgDB, pubRes, nameToSearch)
+			err = r.manageUpdate(ctx, instance, pg, pgDB, pubRes, nameToSearch, publishedTables)
 			// Check error
 			if err != nil {
 				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 			}
 		} else {
 			// Check if reconcile from PG state is necessary because spec haven't been changed
-			need, err2 := r.isReconcileOnPGNecessary(ctx, instance, pg, pgDB, pubRes, nameToSearch)
+			need, err2 := r.isReconcileOnPGNecessary(ctx, instance, pg, pgDB, pubRes, nameToSearch, publishedTables, discoveredTables)
 			// Check error
 			if err2 != nil {
 				return r.manageError(ctx, reqLogger, instance, originalPatch, err2)
@@ -271,7 +333,7 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 			if need {
 				reqLogger.Info("PG state have been changed but not via operator, update need to be done")
 
-				err2 = r.manageUpdate(ctx, instance, pg, pgDB, pubRes, nameToSearch)
+				err2 = r.manageUpdate(ctx, instance, pg, pgDB, pubRes, nameToSearch, publishedTables)
 				// Check error
 				if err2 != nil {
 					return r.manageError(ctx, reqLogger, instance, originalPatch, err2)
@@ -280,11 +342,11 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 		}
 
 		// Check if owner are aligned
-		if pgDB.Status.Roles.Owner != pubRes.Owner {
+		if owner != pubRes.Owner {
 			reqLogger.Info("Owner aren't aligned, update need to be done")
 
 			// Change owner
-			err = pg.ChangePublicationOwner(ctx, pgDB.Status.Database, nameToSearch, pgDB.Status.Roles.Owner)
+			err = pg.ChangePublicationOwner(ctx, pgDB.Status.Database, nameToSearch, owner)
 			// Check error
 			if err != nil {
 				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -302,7 +364,7 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 	// Check if replication slot hasn't been found in database
 	if replicationSlotResult == nil {
 		// Create it
-		err = pg.CreateReplicationSlot(ctx, pgDB.Status.Database, instance.Spec.ReplicationSlotName, instance.Spec.ReplicationSlotPlugin)
+		err = pg.CreateReplicationSlot(ctx, pgDB.Status.Database, instance.Spec.ReplicationSlotName, instance.Spec.ReplicationSlotPlugin, nil)
 		// Check error
 		if err != nil {
 			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -323,15 +385,47 @@ func (r *PostgresqlPublicationReconciler) mainReconcile(
 		}
 	}
 
+	// Check published tables replica identity
+	err = r.manageReplicaIdentityCheck(ctx, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Manage CDC replication user
+	err = r.manageCDCReplicationUser(ctx, reqLogger, instance, pg, pgDB, pgEngCfg)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Monitor replication slot
+	err = r.manageReplicationSlotMonitoring(ctx, instance, pg, utils.CreateNameKey(pgEngCfg.Name, pgEngCfg.Namespace, pgDB.Namespace))
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Apply replication slot safeguard
+	err = r.manageReplicationSlotSafeguard(ctx, instance, pg, pgDB, utils.CreateNameKey(pgEngCfg.Name, pgEngCfg.Namespace, pgDB.Namespace))
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
 	// Save name
 	instance.Status.Name = instance.Spec.Name
 	// Save hash in status
 	instance.Status.Hash = hash
 	// Save for all tables
 	instance.Status.AllTables = &instance.Spec.AllTables
+	// Save owner
+	instance.Status.Owner = owner
 	// Save replication data
 	instance.Status.ReplicationSlotName = instance.Spec.ReplicationSlotName
 	instance.Status.ReplicationSlotPlugin = instance.Spec.ReplicationSlotPlugin
+	// Save discovered tables
+	instance.Status.DiscoveredTables = discoveredTables
 
 	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
 }
@@ -343,9 +437,19 @@ func (*PostgresqlPublicationReconciler) isReconcileOnPGNecessary(
 	pgDB *v1alpha1.PostgresqlDatabase,
 	pubRes *postgres.PublicationResult,
 	currentPublicationName string,
+	publishedTables []*v1alpha1.PostgresqlPublicationTable,
+	discoveredTables []string,
 ) (bool, error) {
 	instanceSpec := instance.Spec
 
+	// Check if discovered tables have changed since last reconcile
+	if instanceSpec.TableSelector != nil {
+		r1, r2 := lo.Difference(discoveredTables, instance.Status.DiscoveredTables)
+		if len(r1) != 0 || len(r2) != 0 {
+			return true, nil
+		}
+	}
+
 	// Check with parameters
 	// nil spec case
 	if instanceSpec.WithParameters == nil && (pubRes.PublicationViaRoot || !pubRes.Delete || !pubRes.Insert || !pubRes.Truncate || !pubRes.Update) {
@@ -439,8 +543,8 @@ func (*PostgresqlPublicationReconciler) isReconcileOnPGNecessary(
 		}
 	} else {
 		// Need to check with tables
-		// Loop over spec table list
-		for _, st := range instanceSpec.Tables {
+		// Loop over published table list
+		for _, st := range publishedTables {
 			// Check if table isn't in the current list
 			detail, found := lo.Find(details, func(it *postgres.PublicationTableDetail) bool {
 				return st.TableName == it.TableName || st.TableName == fmt.Sprintf("%s.%s", it.SchemaName, it.TableName)
@@ -466,18 +570,7 @@ func (*PostgresqlPublicationReconciler) isReconcileOnPGNecessary(
 			if st.Columns == nil {
 				// If so, get real columns from table and check if list aren't identical
 				// Split spec table name
-				spl := strings.Split(st.TableName, ".")
-
-				var schemaName, tableName string
-
-				// Check split size
-				if len(spl) == 1 {
-					schemaName = defaultPGPublicSchemaName
-					tableName = spl[0]
-				} else {
-					schemaName = spl[0]
-					tableName = spl[1]
-				}
+				schemaName, tableName := splitTableName(st.TableName)
 
 				columnNamesToCheck, err = pg.GetColumnNamesFromTable(ctx, pgDB.Status.Database, schemaName, tableName)
 				if err != nil {
@@ -506,6 +599,7 @@ func (*PostgresqlPublicationReconciler) manageUpdate(
 	pgDB *v1alpha1.PostgresqlDatabase,
 	pubRes *postgres.PublicationResult,
 	currentPublicationName string,
+	publishedTables []*v1alpha1.PostgresqlPublicationTable,
 ) error {
 	// Check that publication in database and spec are aligned on "for all tables" as this cannot be changed
 	if pubRes.AllTables != instance.Spec.AllTables {
@@ -525,10 +619,27 @@ func (*PostgresqlPublicationReconciler) manageUpdate(
 	builder = builder.SetTablesInSchema(instance.Spec.TablesInSchema)
 
 	// Loop over tables
-	for _, t := range instance.Spec.Tables {
+	for _, t := range publishedTables {
 		builder = builder.AddSetTable(t.TableName, t.Columns, t.AdditionalWhere)
 	}
 
+	// Check if all tables must be removed as nothing is selected anymore
+	// ? Note: This can happen with a table selector without any match or with empty schema list before PostgreSQL 15
+	// ? Drop is only used by builder when nothing is set
+	if !instance.Spec.AllTables && len(publishedTables) == 0 {
+		// Get publication details
+		details, err := pg.GetPublicationTablesDetails(ctx, pgDB.Status.Database, currentPublicationName)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Loop over current tables
+		for _, it := range details {
+			builder = builder.AddDropTable(fmt.Sprintf("\"%s\".\"%s\"", it.SchemaName, it.TableName))
+		}
+	}
+
 	// Check if there are with options
 	if instance.Spec.WithParameters != nil {
 		// Change with
@@ -555,6 +666,8 @@ func (*PostgresqlPublicationReconciler) manageCreate(
 	instance *v1alpha1.PostgresqlPublication,
 	pg postgres.PG,
 	pgDB *v1alpha1.PostgresqlDatabase,
+	publishedTables []*v1alpha1.PostgresqlPublicationTable,
+	owner string,
 ) error {
 	// Save spec for easy use
 	spec := instance.Spec
@@ -577,12 +690,12 @@ func (*PostgresqlPublicationReconciler) manageCreate(
 	}
 
 	// Manage tables
-	lo.ForEach(spec.Tables, func(table *v1alpha1.PostgresqlPublicationTable, _ int) {
+	lo.ForEach(publishedTables, func(table *v1alpha1.PostgresqlPublicationTable, _ int) {
 		builder = builder.AddTable(table.TableName, table.Columns, table.AdditionalWhere)
 	})
 
 	// Manage owner
-	builder = builder.SetOwner(pgDB.Status.Roles.Owner)
+	builder = builder.SetOwner(owner)
 
 	// Create publication
 	err := pg.CreatePublication(ctx, pgDB.Status.Database, builder)
@@ -595,6 +708,99 @@ func (*PostgresqlPublicationReconciler) manageCreate(
 	return nil
 }
 
+func (r *PostgresqlPublicationReconciler) getPublicationOwner(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) (string, error) {
+	// Check if owner isn't set
+	if instance.Spec.Owner == nil {
+		return pgDB.Status.Roles.Owner, nil
+	}
+
+	// Switch on type
+	switch instance.Spec.Owner.Type {
+	case v1alpha1.EngineConfigurationUserPublicationOwnerType:
+		return pg.GetUser(), nil
+	case v1alpha1.UserRolePublicationOwnerType:
+		// Save link for easy use
+		link := instance.Spec.Owner.UserRole
+
+		// Try to get namespace from spec
+		namespace := link.Namespace
+		if namespace == "" {
+			// Namespace not found, take it from instance namespace
+			namespace = instance.Namespace
+		}
+
+		// Get user role
+		userRole := &v1alpha1.PostgresqlUserRole{}
+		err := r.Get(ctx, types.NamespacedName{Name: link.Name, Namespace: namespace}, userRole)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+
+		// Check that role have been created
+		if userRole.Status.PostgresRole == "" {
+			return "", errors.NewBadRequest(fmt.Sprintf("owner PostgresqlUserRole %s/%s isn't ready", namespace, link.Name))
+		}
+
+		return userRole.Status.PostgresRole, nil
+	default:
+		return pgDB.Status.Roles.Owner, nil
+	}
+}
+
+func (*PostgresqlPublicationReconciler) checkServerSupport(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+) error {
+	// Check if there isn't any option depending on version
+	_, found := lo.Find(instance.Spec.Tables, func(it *v1alpha1.PostgresqlPublicationTable) bool {
+		return it.Columns != nil || it.AdditionalWhere != nil
+	})
+	viaRoot := instance.Spec.WithParameters != nil && instance.Spec.WithParameters.PublishViaPartitionRoot != nil
+
+	if !found && !viaRoot {
+		return nil
+	}
+
+	// Get server version
+	version, err := pg.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check publish via partition root
+	if viaRoot && version < postgres.PublicationViaRootMinVersion {
+		return errors.NewBadRequest(fmt.Sprintf("publish via partition root requires PostgreSQL 13 or later, server version is %d", version))
+	}
+
+	// Check if version supports everything
+	if version >= postgres.PublicationTablesInSchemaMinVersion {
+		return nil
+	}
+
+	// Loop over tables
+	for _, it := range instance.Spec.Tables {
+		// Check columns
+		if it.Columns != nil {
+			return errors.NewBadRequest(fmt.Sprintf("columns list on table %s requires PostgreSQL 15 or later, server version is %d", it.TableName, version))
+		}
+
+		// Check additional where
+		if it.AdditionalWhere != nil {
+			return errors.NewBadRequest(fmt.Sprintf("additional where on table %s requires PostgreSQL 15 or later, server version is %d", it.TableName, version))
+		}
+	}
+
+	return nil
+}
+
 func (*PostgresqlPublicationReconciler) validate(
 	instance *v1alpha1.PostgresqlPublication,
 ) error {
@@ -613,8 +819,8 @@ func (*PostgresqlPublicationReconciler) validate(
 	tablesLength := len(spec.Tables)
 
 	// check that something have been asked
-	if !spec.AllTables && tablesInSchemaLength == 0 && tablesLength == 0 {
-		return errors.NewBadRequest("nothing is selected for publication (no all tables, no tables in schema, no tables)")
+	if !spec.AllTables && tablesInSchemaLength == 0 && tablesLength == 0 && spec.TableSelector == nil {
+		return errors.NewBadRequest("nothing is selected for publication (no all tables, no tables in schema, no tables, no table selector)")
 	}
 
 	// Check all tables vs other case
@@ -668,109 +874,100 @@ func (*PostgresqlPublicationReconciler) validate(
 		return errors.NewBadRequest("tables cannot have a columns list with an empty name or have a columns list with a table schema list enabled or an empty additional where")
 	}
 
-	// Default
-	return nil
-}
-
-func (r *PostgresqlPublicationReconciler) updateInstance(
-	ctx context.Context,
-	instance *v1alpha1.PostgresqlPublication,
-) (bool, error) {
-	// Deep copy
-	oCopy := instance.DeepCopy()
-
-	// Add finalizer
-	controllerutil.AddFinalizer(instance, config.Finalizer)
-
-	// Check if replication slot name isn't set
-	if instance.Spec.ReplicationSlotName == "" {
-		// Set to publication name
-		instance.Spec.ReplicationSlotName = instance.Spec.Name
-	}
+	// Check owner
+	if spec.Owner != nil {
+		// Check user role type
+		if spec.Owner.Type == v1alpha1.UserRolePublicationOwnerType && (spec.Owner.UserRole == nil || spec.Owner.UserRole.Name == "") {
+			return errors.NewBadRequest("owner user role must be set with UserRole owner type")
+		}
 
-	// Check if replication slot plugin isn't set
-	if instance.Spec.ReplicationSlotPlugin == "" {
-		// Set to default
-		instance.Spec.ReplicationSlotPlugin = DefaultReplicationSlotPlugin
+		// Check other types
+		if spec.Owner.Type != v1alpha1.UserRolePublicationOwnerType && spec.Owner.UserRole != nil {
+			return errors.NewBadRequest("owner user role can only be set with UserRole owner type")
+		}
 	}
 
-	// Check if update is needed
-	if !reflect.DeepEqual(oCopy.ObjectMeta, instance.ObjectMeta) {
-		return true, r.Update(ctx, instance)
+	// Check table selector
+	if spec.TableSelector != nil {
+		err := validateTableSelector(spec)
+		// Check error
+		if err != nil {
+			return err
+		}
 	}
 
-	return false, nil
-}
-
-func (r *PostgresqlPublicationReconciler) manageDropPublication(
-	ctx context.Context,
-	logger logr.Logger,
-	instance *v1alpha1.PostgresqlPublication,
-) error {
-	// Get pg db
-	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
-	if err != nil && !errors.IsNotFound(err) {
+	// Check replica identities
+	err := validateReplicaIdentities(spec)
+	// Check error
+	if err != nil {
 		return err
 	}
-	// In case of not found => Can't delete => skip
-	if errors.IsNotFound(err) {
-		logger.Error(err, "can't delete publication because PostgresDatabase didn't exists anymore")
-
-		return nil
-	}
 
-	// Try to find PostgresqlEngineConfiguration CR
-	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
-	if err != nil && !errors.IsNotFound(err) {
-		return err
+	// Check CDC
+	if spec.CDC != nil {
+		err = validateCDC(spec, status)
+		// Check error
+		if err != nil {
+			return err
+		}
 	}
-	// In case of not found => Can't delete => skip
-	if errors.IsNotFound(err) {
-		logger.Error(err, "can't delete database because PostgresEngineConfiguration didn't exists anymore")
 
-		return nil
-	}
+	// Check replication slot monitoring
+	if spec.SlotMonitoring != nil {
+		// Check durations
+		if spec.SlotMonitoring.CheckInterval != "" {
+			_, err := time.ParseDuration(spec.SlotMonitoring.CheckInterval)
+			// Check error
+			if err != nil {
+				return errors.NewBadRequest("slot monitoring check interval must be a valid duration")
+			}
+		}
 
-	// Get secret linked to PostgresqlEngineConfiguration CR
-	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
-	if err != nil {
-		return err
-	}
+		if spec.SlotMonitoring.InactiveWarningThreshold != "" {
+			_, err := time.ParseDuration(spec.SlotMonitoring.InactiveWarningThreshold)
+			// Check error
+			if err != nil {
+				return errors.NewBadRequest("slot monitoring inactive warning threshold must be a valid duration")
+			}
+		}
 
-	// Create PG instance
-	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
+		// Check quantities
+		if spec.SlotMonitoring.RetainedWALWarningThreshold != "" {
+			_, err := resource.ParseQuantity(spec.SlotMonitoring.RetainedWALWarningThreshold)
+			// Check error
+			if err != nil {
+				return errors.NewBadRequest("slot monitoring retained WAL warning threshold must be a valid quantity")
+			}
+		}
 
-	// Get publication
-	pub, err := pg.GetPublication(ctx, pgDB.Status.Database, instance.Spec.Name)
-	if err != nil {
-		return err
+		if spec.SlotMonitoring.ConfirmedFlushLagWarningThreshold != "" {
+			_, err := resource.ParseQuantity(spec.SlotMonitoring.ConfirmedFlushLagWarningThreshold)
+			// Check error
+			if err != nil {
+				return errors.NewBadRequest("slot monitoring confirmed flush lag warning threshold must be a valid quantity")
+			}
+		}
 	}
 
-	// Check if publication is still present to delete it
-	if pub != nil {
-		// Drop publication
-		err = pg.DropPublication(ctx, pgDB.Status.Database, instance.Spec.Name)
-		// Check error
-		if err != nil {
-			return err
+	// Check replication slot safeguard
+	if spec.SlotSafeguard != nil {
+		if spec.SlotSafeguard.MaxRetainedWAL == "" && spec.SlotSafeguard.MaxInactiveDuration == "" {
+			return errors.NewBadRequest("slot safeguard must have a max retained WAL or a max inactive duration")
 		}
-	}
 
-	// Check if replication slot is defined
-	if instance.Spec.ReplicationSlotName != "" {
-		// Get replication slot
-		rep, err := pg.GetReplicationSlot(ctx, instance.Spec.ReplicationSlotName)
-		if err != nil {
-			return err
+		if spec.SlotSafeguard.MaxRetainedWAL != "" {
+			_, err := resource.ParseQuantity(spec.SlotSafeguard.MaxRetainedWAL)
+			// Check error
+			if err != nil {
+				return errors.NewBadRequest("slot safeguard max retained WAL must be a valid quantity")
+			}
 		}
 
-		// Check if replication slot is still present to delete it
-		if rep != nil {
-			// Drop replication slot
-			err = pg.DropReplicationSlot(ctx, instance.Spec.ReplicationSlotName)
+		if spec.SlotSafeguard.MaxInactiveDuration != "" {
+			_, err := time.ParseDuration(spec.SlotSafeguard.MaxInactiveDuration)
 			// Check error
 			if err != nil {
-				return err
+				return errors.NewBadRequest("slot safeguard max inactive duration must be a valid duration")
 			}
 		}
 	}
@@ -779,29 +976,1181 @@ func (r *PostgresqlPublicationReconciler) manageDropPublication(
 	return nil
 }
 
-func (r *PostgresqlPublicationReconciler) manageError(
-	ctx context.Context,
-	logger logr.Logger,
-	instance *v1alpha1.PostgresqlPublication,
-	originalPatch client.Patch,
-	issue error,
-) (reconcile.Result, error) {
-	logger.Error(issue, "issue raised in reconcile")
-	// Add kubernetes event
-	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+func validateCDC(spec v1alpha1.PostgresqlPublicationSpec, status v1alpha1.PostgresqlPublicationStatus) error {
+	// Save cdc for easy use
+	cdc := spec.CDC
+
+	// Check tables in schema case
+	// ? Note: Tables in another schema cannot be added without breaking the tables in schema reconcile
+	if len(spec.TablesInSchema) != 0 && (cdc.SignalingTableName != "" || cdc.HeartbeatTableName != "") {
+		// Compute schema
+		sch := cdc.Schema
+		if sch == "" {
+			sch = defaultPGPublicSchemaName
+		}
 
-	// Update status
-	instance.Status.Message = issue.Error()
-	instance.Status.Ready = false
-	instance.Status.Phase = v1alpha1.PublicationFailedPhase
+		if !lo.Contains(spec.TablesInSchema, sch) {
+			return errors.NewBadRequest("cdc schema must be listed in tables in schema")
+		}
+	}
 
-	// Increase fail counter
-	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+	// Check replica identities
+	_, found := lo.Find(cdc.ReplicaIdentities, func(it *v1alpha1.PostgresqlPublicationCDCReplicaIdentity) bool {
+		return it.TableName == ""
+	})
+	// Check
+	if found {
+		return errors.NewBadRequest("cdc replica identities cannot have an empty table name")
+	}
 
-	// Patch status
-	err := r.Status().Patch(ctx, instance, originalPatch)
-	if err != nil {
-		logger.Error(err, "unable to update status")
+	// Check replication user
+	if cdc.ReplicationUser != nil {
+		if cdc.ReplicationUser.GeneratedSecretName == "" {
+			return errors.NewBadRequest("cdc replication user generated secret name must have a value")
+		}
+
+		// Check role name change
+		if status.CDCReplicationUserRoleName != "" &&
+			cdc.ReplicationUser.RoleName != "" &&
+			status.CDCReplicationUserRoleName != cdc.ReplicationUser.RoleName {
+			return errors.NewBadRequest("cannot change cdc replication user role name on an upgrade")
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func validateTableSelector(spec v1alpha1.PostgresqlPublicationSpec) error {
+	// Save selector for easy use
+	selector := spec.TableSelector
+
+	// Check all tables and tables in schema case
+	if spec.AllTables || len(spec.TablesInSchema) != 0 {
+		return errors.NewBadRequest("table selector cannot be set with all tables or tables in schema")
+	}
+
+	// Check that something is selected
+	if len(selector.Include) == 0 && selector.CommentContains == "" {
+		return errors.NewBadRequest("table selector must have include patterns or a comment filter")
+	}
+
+	// Check patterns
+	for _, it := range append(append([]string{}, selector.Include...), selector.Exclude...) {
+		_, err := buildTableSelectorMatcher(selector.PatternType, it)
+		// Check error
+		if err != nil {
+			return errors.NewBadRequest(fmt.Sprintf("table selector pattern %s is invalid: %s", it, err.Error()))
+		}
+	}
+
+	// Default
+	return nil
+}
+
+// buildTableSelectorMatcher will return a matcher function for a table selector pattern.
+// Regex patterns are anchored to match the full "schema.table" name.
+func buildTableSelectorMatcher(patternType v1alpha1.TableSelectorPatternType, pattern string) (func(string) bool, error) {
+	// Check empty pattern
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern cannot be empty")
+	}
+
+	// Regex case
+	if patternType == v1alpha1.RegexTableSelectorPatternType {
+		reg, err := regexp.Compile("^(?:" + pattern + ")$")
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		return reg.MatchString, nil
+	}
+
+	// Glob case
+	// Check pattern
+	_, err := path.Match(pattern, "")
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return func(name string) bool {
+		res, _ := path.Match(pattern, name)
+
+		return res
+	}, nil
+}
+
+// selectTables will return "schema.table" names of tables matching table selector.
+func selectTables(selector *v1alpha1.PostgresqlPublicationTableSelector, tables []*postgres.TableWithComment) ([]string, error) {
+	// Build matchers
+	buildMatchers := func(patterns []string) ([]func(string) bool, error) {
+		res := []func(string) bool{}
+
+		for _, it := range patterns {
+			m, err := buildTableSelectorMatcher(selector.PatternType, it)
+			// Check error
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, m)
+		}
+
+		return res, nil
+	}
+
+	includes, err := buildMatchers(selector.Include)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	excludes, err := buildMatchers(selector.Exclude)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+
+	// Loop over tables
+	for _, it := range tables {
+		name := fmt.Sprintf("%s.%s", it.SchemaName, it.TableName)
+		matchName := func(m func(string) bool) bool { return m(name) }
+
+		// Check include
+		if len(includes) != 0 && !lo.SomeBy(includes, matchName) {
+			continue
+		}
+
+		// Check exclude
+		if lo.SomeBy(excludes, matchName) {
+			continue
+		}
+
+		// Check comment
+		if selector.CommentContains != "" && !strings.Contains(it.Comment, selector.CommentContains) {
+			continue
+		}
+
+		// Save
+		res = append(res, name)
+	}
+
+	return res, nil
+}
+
+func validateReplicaIdentities(spec v1alpha1.PostgresqlPublicationSpec) error {
+	// Build list of all replica identities
+	list := []*v1alpha1.PostgresqlPublicationCDCReplicaIdentity{}
+
+	for _, it := range spec.Tables {
+		if it.ReplicaIdentity != "" {
+			list = append(list, &v1alpha1.PostgresqlPublicationCDCReplicaIdentity{TableName: it.TableName, ReplicaIdentity: it.ReplicaIdentity})
+		}
+	}
+
+	if spec.CDC != nil {
+		list = append(list, spec.CDC.ReplicaIdentities...)
+	}
+
+	// Save identities by table
+	identities := map[string]v1alpha1.PublicationReplicaIdentity{}
+
+	// Loop over list
+	for _, it := range list {
+		// Normalize table name
+		schemaName, tableName := splitTableName(it.TableName)
+		key := fmt.Sprintf("%s.%s", schemaName, tableName)
+
+		// Check conflict
+		if v, ok := identities[key]; ok && v != it.ReplicaIdentity {
+			return errors.NewBadRequest(fmt.Sprintf("replica identity for table %s is set multiple times with different values", it.TableName))
+		}
+
+		identities[key] = it.ReplicaIdentity
+	}
+
+	// Default
+	return nil
+}
+
+// getPublishedTables will return spec tables with CDC tables added when publication is based on a table list.
+func getPublishedTables(instance *v1alpha1.PostgresqlPublication) []*v1alpha1.PostgresqlPublicationTable {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Check if CDC tables must be added
+	// ? Note: All tables and tables in schema publications already contain them
+	if spec.CDC == nil || spec.AllTables || len(spec.TablesInSchema) != 0 {
+		return spec.Tables
+	}
+
+	res := spec.Tables
+
+	// Loop over CDC tables
+	for _, name := range []string{spec.CDC.SignalingTableName, spec.CDC.HeartbeatTableName} {
+		// Ignore disabled tables
+		if name == "" {
+			continue
+		}
+
+		// Check if table is already listed
+		_, found := lo.Find(spec.Tables, func(it *v1alpha1.PostgresqlPublicationTable) bool {
+			itSchema, itTable := splitTableName(it.TableName)
+
+			return itSchema == spec.CDC.Schema && itTable == name
+		})
+		// Check
+		if !found {
+			res = append(res, &v1alpha1.PostgresqlPublicationTable{TableName: fmt.Sprintf("%s.%s", spec.CDC.Schema, name)})
+		}
+	}
+
+	return res
+}
+
+// computePublishedTables will return published tables with tables discovered by table selector and the discovered table list.
+func (*PostgresqlPublicationReconciler) computePublishedTables(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) ([]*v1alpha1.PostgresqlPublicationTable, []string, error) {
+	// Get tables from spec
+	res := getPublishedTables(instance)
+
+	// Check if there isn't any table selector
+	if instance.Spec.TableSelector == nil {
+		return res, nil, nil
+	}
+
+	// List tables in database
+	tables, err := pg.ListTablesWithComment(ctx, pgDB.Status.Database)
+	// Check error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Select tables
+	discovered, err := selectTables(instance.Spec.TableSelector, tables)
+	// Check error
+	if err != nil {
+		return nil, nil, errors.NewBadRequest(err.Error())
+	}
+
+	// Copy list to avoid any spec change
+	res = append([]*v1alpha1.PostgresqlPublicationTable{}, res...)
+
+	// Loop over discovered tables
+	for _, name := range discovered {
+		schemaName, tableName := splitTableName(name)
+
+		// Check if table is already listed
+		_, found := lo.Find(res, func(it *v1alpha1.PostgresqlPublicationTable) bool {
+			itSchema, itTable := splitTableName(it.TableName)
+
+			return itSchema == schemaName && itTable == tableName
+		})
+		// Check
+		if !found {
+			res = append(res, &v1alpha1.PostgresqlPublicationTable{TableName: name})
+		}
+	}
+
+	return res, discovered, nil
+}
+
+// splitTableName will split a table name with an optional schema.
+func splitTableName(name string) (string, string) {
+	// Split
+	spl := strings.SplitN(name, ".", 2) //nolint:mnd // Schema and table
+	// Check split size
+	if len(spl) == 1 {
+		return defaultPGPublicSchemaName, spl[0]
+	}
+
+	return spl[0], spl[1]
+}
+
+func (*PostgresqlPublicationReconciler) manageCDCTables(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Save cdc for easy use
+	cdc := instance.Spec.CDC
+
+	// Check if CDC is enabled
+	if cdc == nil {
+		return nil
+	}
+
+	// Check if signaling table is enabled
+	if cdc.SignalingTableName != "" {
+		// Create it
+		err := pg.CreateCDCSignalingTable(ctx, pgDB.Status.Database, cdc.Schema, cdc.SignalingTableName, pgDB.Status.Roles.Owner)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if heartbeat table is enabled
+	if cdc.HeartbeatTableName != "" {
+		// Create it
+		err := pg.CreateCDCHeartbeatTable(ctx, pgDB.Status.Database, cdc.Schema, cdc.HeartbeatTableName, pgDB.Status.Roles.Owner)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (*PostgresqlPublicationReconciler) manageReplicaIdentities(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Loop over wanted replica identities
+	for tableName, identity := range getWantedReplicaIdentities(instance) {
+		schemaName, name := splitTableName(tableName)
+
+		// Get current replica identity
+		current, err := pg.GetTableReplicaIdentity(ctx, pgDB.Status.Database, schemaName, name)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Check if table exists
+		if current == "" {
+			return errors.NewBadRequest(fmt.Sprintf("replica identity table %s doesn't exist", tableName))
+		}
+
+		// Check if update is needed
+		if current != string(identity) {
+			err = pg.SetTableReplicaIdentity(ctx, pgDB.Status.Database, schemaName, name, string(identity))
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Default
+	return nil
+}
+
+// getWantedReplicaIdentities will return replica identities from tables and CDC indexed by table name.
+func getWantedReplicaIdentities(instance *v1alpha1.PostgresqlPublication) map[string]v1alpha1.PublicationReplicaIdentity {
+	res := map[string]v1alpha1.PublicationReplicaIdentity{}
+
+	// Loop over tables
+	for _, it := range instance.Spec.Tables {
+		if it.ReplicaIdentity != "" {
+			res[it.TableName] = it.ReplicaIdentity
+		}
+	}
+
+	// Check if CDC is enabled
+	if instance.Spec.CDC != nil {
+		// Loop over CDC replica identities
+		for _, it := range instance.Spec.CDC.ReplicaIdentities {
+			res[it.TableName] = it.ReplicaIdentity
+		}
+	}
+
+	return res
+}
+
+func (r *PostgresqlPublicationReconciler) manageReplicaIdentityCheck(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Check if update or delete are published
+	// ? Note: Insert and truncate don't need any replica identity
+	if instance.Spec.WithParameters != nil && instance.Spec.WithParameters.Publish != "" {
+		publish := strings.ToLower(instance.Spec.WithParameters.Publish)
+
+		if !strings.Contains(publish, "update") && !strings.Contains(publish, "delete") {
+			// Clean status
+			instance.Status.TablesWithoutReplicaIdentity = nil
+
+			return nil
+		}
+	}
+
+	// Get tables without replica identity
+	tables, err := pg.GetPublicationTablesWithoutReplicaIdentity(ctx, pgDB.Status.Database, instance.Spec.Name)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if there is a change to report
+	if len(tables) != 0 && !reflect.DeepEqual(tables, instance.Status.TablesWithoutReplicaIdentity) {
+		r.Recorder.Eventf(
+			instance, "Warning", "MissingReplicaIdentity",
+			"Published tables without any usable replica identity, updates and deletes will fail on them: %s",
+			strings.Join(tables, ", "),
+		)
+	}
+
+	// Save
+	if len(tables) == 0 {
+		instance.Status.TablesWithoutReplicaIdentity = nil
+	} else {
+		instance.Status.TablesWithoutReplicaIdentity = tables
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlPublicationReconciler) manageCDCReplicationUser(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+	pgEngCfg *v1alpha1.PostgresqlEngineConfiguration,
+) error {
+	// Check if CDC replication user is enabled
+	if instance.Spec.CDC == nil || instance.Spec.CDC.ReplicationUser == nil {
+		return nil
+	}
+
+	// Save for easy use
+	user := instance.Spec.CDC.ReplicationUser
+	role := user.RoleName
+
+	// Check if secret already exists
+	secrFound := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: user.GeneratedSecretName, Namespace: instance.Namespace}, secrFound)
+	// Check if error exists and not a not found error
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	secretNotFound := err != nil
+
+	// Get password from secret
+	password := ""
+	if !secretNotFound {
+		password = string(secrFound.Data[SecretMainKeyPassword])
+	}
+
+	// Check if role exists
+	exists, err := pg.IsRoleExist(ctx, role)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Manage role
+	if !exists {
+		// Generate password if needed
+		if password == "" {
+			password = utils.GetRandomString(ManagedPasswordSize)
+		}
+
+		// Create role
+		_, err = pg.CreateUserRole(ctx, role, password, &postgres.RoleAttributes{Replication: lo.ToPtr(true)})
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "Processing", "CDC replication user %s created", role)
+	} else {
+		// Check that role is managed by this publication
+		if instance.Status.CDCReplicationUserRoleName != role {
+			return errors.NewBadRequest(fmt.Sprintf("cdc replication user role %s already exists and isn't managed by this publication", role))
+		}
+
+		// Check if password is lost
+		if password == "" {
+			// Generate a new one
+			password = utils.GetRandomString(ManagedPasswordSize)
+
+			// Update password
+			err = pg.UpdatePassword(ctx, role, password)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+
+		// Get attributes
+		attributes, err2 := pg.GetRoleAttributes(ctx, role)
+		// Check error
+		if err2 != nil {
+			return err2
+		}
+
+		// Check replication and login attributes
+		if attributes == nil || attributes.Replication == nil || !*attributes.Replication || attributes.Login == nil || !*attributes.Login {
+			err = pg.AlterRoleAttributes(ctx, role, &postgres.RoleAttributes{Replication: lo.ToPtr(true), Login: lo.ToPtr(true)})
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Save role
+	instance.Status.CDCReplicationUserRoleName = role
+
+	// Manage grants on published and CDC tables
+	err = r.manageCDCReplicationUserGrants(ctx, instance, pg, pgDB, role)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Generate secret
+	generatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      user.GeneratedSecretName,
+			Namespace: instance.Namespace,
+			Labels: map[string]string{
+				"app": instance.Name,
+			},
+		},
+		Data: buildPGUserSecretData(
+			pgEngCfg,
+			v1alpha1.PrimaryConnectionType,
+			user.ExtraConnectionURLParameters,
+			pgDB.Status.Database,
+			role, password,
+		),
+	}
+
+	// Set owner references
+	err = controllerutil.SetControllerReference(instance, generatedSecret, r.Scheme)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if secret must be created
+	if secretNotFound {
+		// Save secret
+		err = r.Create(ctx, generatedSecret)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Successfully created CDC replication user secret", "secret", generatedSecret.Name)
+		r.Recorder.Eventf(instance, "Normal", "Updated", "Generated secret %s saved", generatedSecret.Name)
+	} else if !reflect.DeepEqual(secrFound.Data, generatedSecret.Data) { // Check if secret is valid, if not, update it
+		// Update secret
+		secrFound.Data = generatedSecret.Data
+
+		// Save secret
+		err = r.Update(ctx, secrFound)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Successfully updated CDC replication user secret", "secret", secrFound.Name)
+		r.Recorder.Eventf(instance, "Normal", "Updated", "Generated secret %s saved", secrFound.Name)
+	}
+
+	// Default
+	return nil
+}
+
+func (*PostgresqlPublicationReconciler) manageCDCReplicationUserGrants(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+	role string,
+) error {
+	// Get published tables
+	details, err := pg.GetPublicationTablesDetails(ctx, pgDB.Status.Database, instance.Spec.Name)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Build wanted grants with select on all published tables
+	wantedGrants := []*postgres.TableGrant{}
+	for _, it := range details {
+		wantedGrants = append(wantedGrants, &postgres.TableGrant{
+			Schema:    it.SchemaName,
+			Table:     it.TableName,
+			Privilege: cdcReplicationUserPrivilege,
+		})
+	}
+
+	// Add write grants on signaling and heartbeat tables
+	// ? Note: Connector must be able to write in them
+	cdc := instance.Spec.CDC
+	for _, name := range []string{cdc.SignalingTableName, cdc.HeartbeatTableName} {
+		// Check if table is enabled
+		if name == "" {
+			continue
+		}
+
+		// Loop over privileges
+		for _, priv := range []string{cdcReplicationUserPrivilege, "INSERT", "UPDATE", "DELETE"} {
+			// Check if grant is already wanted
+			_, found := lo.Find(wantedGrants, func(g *postgres.TableGrant) bool {
+				return g.Schema == cdc.Schema && g.Table == name && g.Privilege == priv
+			})
+			// Check
+			if !found {
+				wantedGrants = append(wantedGrants, &postgres.TableGrant{Schema: cdc.Schema, Table: name, Privilege: priv})
+			}
+		}
+	}
+
+	// Get current grants
+	currentGrants, err := pg.GetRoleTableGrants(ctx, pgDB.Status.Database, role)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Loop over wanted grants to grant missing ones
+	for _, it := range wantedGrants {
+		// Check if grant already exists
+		_, found := lo.Find(currentGrants, func(g *postgres.TableGrant) bool {
+			return g.Schema == it.Schema && g.Table == it.Table && g.Privilege == it.Privilege
+		})
+		// Check
+		if found {
+			continue
+		}
+
+		// Grant usage on schema
+		err = pg.GrantUsageOnSchema(ctx, pgDB.Status.Database, it.Schema, role)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Grant
+		err = pg.GrantOnTable(ctx, pgDB.Status.Database, it.Schema, it.Table, it.Privilege, role)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Loop over current grants to revoke the ones that aren't wanted anymore
+	for _, g := range currentGrants {
+		// Check if grant is still wanted
+		_, found := lo.Find(wantedGrants, func(it *postgres.TableGrant) bool {
+			return g.Schema == it.Schema && g.Table == it.Table && g.Privilege == it.Privilege
+		})
+		// Check
+		if found {
+			continue
+		}
+
+		// Revoke
+		err = pg.RevokeOnTable(ctx, pgDB.Status.Database, g.Schema, g.Table, g.Privilege, role)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlPublicationReconciler) manageReplicationSlotSafeguard(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+	engine string,
+) error {
+	// Check if there isn't any safeguard or replication slot status
+	if instance.Spec.SlotSafeguard == nil || instance.Status.ReplicationSlot == nil {
+		// Clean status
+		instance.Status.SlotSafeguard = nil
+
+		return nil
+	}
+
+	// Init status
+	if instance.Status.SlotSafeguard == nil {
+		instance.Status.SlotSafeguard = &v1alpha1.PublicationSlotSafeguardStatus{}
+	}
+
+	// Compute reason
+	reason, err := getReplicationSlotSafeguardReason(instance.Spec.SlotSafeguard, instance.Status.ReplicationSlot)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if safeguard isn't triggered
+	if reason == "" {
+		instance.Status.SlotSafeguard.Triggered = false
+
+		return nil
+	}
+
+	// Get action
+	action := instance.Spec.SlotSafeguard.Action
+	if action == "" {
+		action = v1alpha1.SlotSafeguardWarnAction
+	}
+
+	// Check if action was already taken for this trigger
+	if instance.Status.SlotSafeguard.Triggered {
+		// Pause must be kept until replication slot is back under limits
+		if action == v1alpha1.SlotSafeguardPauseAction {
+			return errors.NewBadRequest("replication slot safeguard triggered, publication is paused: " + reason)
+		}
+
+		return nil
+	}
+
+	// ? Note: Trigger and action are saved only when action succeeded, otherwise it will be retried
+	switch action {
+	case v1alpha1.SlotSafeguardRecreateAction:
+		// Terminate consumer as an active replication slot cannot be dropped
+		err = pg.TerminateReplicationSlotConsumer(ctx, instance.Spec.ReplicationSlotName)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Check that consumer have exited
+		stats, err := pg.GetReplicationSlotStats(ctx, instance.Spec.ReplicationSlotName)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Check if it is still active
+		if stats != nil && stats.Active {
+			return errors.NewBadRequest(
+				fmt.Sprintf("replication slot %s is still active, waiting for consumer to exit before recreating it", instance.Spec.ReplicationSlotName),
+			)
+		}
+
+		// Drop replication slot
+		err = pg.DropReplicationSlot(ctx, instance.Spec.ReplicationSlotName)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Create it again
+		err = pg.CreateReplicationSlot(ctx, pgDB.Status.Database, instance.Spec.ReplicationSlotName, instance.Spec.ReplicationSlotPlugin, nil)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		r.Recorder.Eventf(
+			instance, "Warning", "ReplicationSlotRecreated",
+			"Replication slot %s has been dropped and recreated, consumer needs a resync: %s", instance.Spec.ReplicationSlotName, reason,
+		)
+
+		// Save action
+		saveSlotSafeguardAction(instance, action, reason)
+
+		// Reset monitoring as this is a new replication slot
+		instance.Status.ReplicationSlot = nil
+		instance.Status.SlotSafeguard.Triggered = false
+
+		return r.manageReplicationSlotMonitoring(ctx, instance, pg, engine)
+	case v1alpha1.SlotSafeguardPauseAction:
+		r.Recorder.Eventf(
+			instance, "Warning", "ReplicationSlotPaused",
+			"Publication paused because of replication slot %s, consumer needs to be checked: %s", instance.Spec.ReplicationSlotName, reason,
+		)
+
+		// Save trigger and action
+		instance.Status.SlotSafeguard.Triggered = true
+		saveSlotSafeguardAction(instance, action, reason)
+
+		return errors.NewBadRequest("replication slot safeguard triggered, publication is paused: " + reason)
+	default:
+		r.Recorder.Eventf(
+			instance, "Warning", "ReplicationSlotSafeguard",
+			"Replication slot %s exceeds safeguard limits, consumer needs to be checked: %s", instance.Spec.ReplicationSlotName, reason,
+		)
+
+		// Save trigger and action
+		instance.Status.SlotSafeguard.Triggered = true
+		saveSlotSafeguardAction(instance, action, reason)
+	}
+
+	return nil
+}
+
+// saveSlotSafeguardAction will save a taken safeguard action in status and keep only last actions.
+func saveSlotSafeguardAction(instance *v1alpha1.PostgresqlPublication, action v1alpha1.SlotSafeguardAction, reason string) {
+	// Save action
+	instance.Status.SlotSafeguard.Actions = append(instance.Status.SlotSafeguard.Actions, v1alpha1.PublicationSlotSafeguardActionStatus{
+		Action: action,
+		Reason: reason,
+		Time:   time.Now().UTC().Format(time.RFC3339),
+	})
+	// Keep only last actions
+	if len(instance.Status.SlotSafeguard.Actions) > maxSlotSafeguardActions {
+		instance.Status.SlotSafeguard.Actions = instance.Status.SlotSafeguard.Actions[len(instance.Status.SlotSafeguard.Actions)-maxSlotSafeguardActions:]
+	}
+}
+
+// getReplicationSlotSafeguardReason will return why replication slot exceeds safeguard limits or an empty string.
+func getReplicationSlotSafeguardReason(
+	safeguard *v1alpha1.PostgresqlPublicationSlotSafeguard,
+	slotStatus *v1alpha1.ReplicationSlotHealthStatus,
+) (string, error) {
+	// Check retained WAL
+	if safeguard.MaxRetainedWAL != "" {
+		maxRetainedWAL, err := resource.ParseQuantity(safeguard.MaxRetainedWAL)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+
+		// Check if limit is exceeded
+		if slotStatus.RetainedWALBytes > maxRetainedWAL.Value() {
+			return fmt.Sprintf("retained WAL is %d bytes which is above maximum %s", slotStatus.RetainedWALBytes, safeguard.MaxRetainedWAL), nil
+		}
+	}
+
+	// Check inactivity
+	if safeguard.MaxInactiveDuration != "" && slotStatus.InactiveSince != "" {
+		maxInactiveDuration, err := time.ParseDuration(safeguard.MaxInactiveDuration)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+
+		// Parse inactive since
+		inactiveSince, err := time.Parse(time.RFC3339, slotStatus.InactiveSince)
+		// Check error
+		if err != nil {
+			return "", err
+		}
+
+		// Check if limit is exceeded
+		if time.Since(inactiveSince) > maxInactiveDuration {
+			return fmt.Sprintf("inactive since %s which is above maximum %s", slotStatus.InactiveSince, safeguard.MaxInactiveDuration), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (r *PostgresqlPublicationReconciler) manageReplicationSlotMonitoring(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+	pg postgres.PG,
+	engine string,
+) error {
+	// Get replication slot stats
+	stats, err := pg.GetReplicationSlotStats(ctx, instance.Spec.ReplicationSlotName)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if replication slot isn't found
+	if stats == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	// Build status
+	slotStatus := buildReplicationSlotHealthStatus(stats, instance.Status.ReplicationSlot, now)
+
+	// Save status
+	instance.Status.ReplicationSlot = slotStatus
+
+	// Update metrics
+	r.ReplicationSlotActive.WithLabelValues(instance.Namespace, instance.Name, instance.Spec.ReplicationSlotName, engine).
+		Set(lo.Ternary(stats.Active, 1.0, 0.0))
+	r.ReplicationSlotRetainedWALBytes.WithLabelValues(instance.Namespace, instance.Name, instance.Spec.ReplicationSlotName, engine).
+		Set(float64(stats.RetainedWALBytes))
+	r.ReplicationSlotConfirmedFlushLag.WithLabelValues(instance.Namespace, instance.Name, instance.Spec.ReplicationSlotName, engine).
+		Set(float64(stats.ConfirmedFlushLagBytes))
+
+	// Check if there isn't any threshold
+	if instance.Spec.SlotMonitoring == nil {
+		return nil
+	}
+
+	// Check retained WAL threshold
+	if instance.Spec.SlotMonitoring.RetainedWALWarningThreshold != "" {
+		threshold := resource.MustParse(instance.Spec.SlotMonitoring.RetainedWALWarningThreshold)
+		// Check if threshold is reached
+		if stats.RetainedWALBytes > threshold.Value() {
+			r.Recorder.Eventf(
+				instance, "Warning", "ReplicationSlotRetainedWAL",
+				"Replication slot %s retains %d bytes of WAL which is above threshold %s",
+				instance.Spec.ReplicationSlotName, stats.RetainedWALBytes, instance.Spec.SlotMonitoring.RetainedWALWarningThreshold,
+			)
+		}
+	}
+
+	// Check confirmed flush lag threshold
+	if instance.Spec.SlotMonitoring.ConfirmedFlushLagWarningThreshold != "" {
+		threshold := resource.MustParse(instance.Spec.SlotMonitoring.ConfirmedFlushLagWarningThreshold)
+		// Check if threshold is reached
+		if stats.ConfirmedFlushLagBytes > threshold.Value() {
+			r.Recorder.Eventf(
+				instance, "Warning", "ReplicationSlotLag",
+				"Replication slot %s confirmed flush lag is %d bytes which is above threshold %s",
+				instance.Spec.ReplicationSlotName, stats.ConfirmedFlushLagBytes, instance.Spec.SlotMonitoring.ConfirmedFlushLagWarningThreshold,
+			)
+		}
+	}
+
+	// Check inactive threshold
+	if instance.Spec.SlotMonitoring.InactiveWarningThreshold != "" && slotStatus.InactiveSince != "" {
+		threshold, err := time.ParseDuration(instance.Spec.SlotMonitoring.InactiveWarningThreshold)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Parse inactive since
+		inactiveSince, err := time.Parse(time.RFC3339, slotStatus.InactiveSince)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		// Check if threshold is reached
+		if now.Sub(inactiveSince) > threshold {
+			r.Recorder.Eventf(
+				instance, "Warning", "ReplicationSlotInactive",
+				"Replication slot %s is inactive since %s which is above threshold %s",
+				instance.Spec.ReplicationSlotName, slotStatus.InactiveSince, instance.Spec.SlotMonitoring.InactiveWarningThreshold,
+			)
+		}
+	}
+
+	return nil
+}
+
+// buildReplicationSlotHealthStatus will build replication slot health status from stats.
+// First inactivity detection time is kept from previous status.
+func buildReplicationSlotHealthStatus(
+	stats *postgres.ReplicationSlotStats,
+	previous *v1alpha1.ReplicationSlotHealthStatus,
+	now time.Time,
+) *v1alpha1.ReplicationSlotHealthStatus {
+	res := &v1alpha1.ReplicationSlotHealthStatus{
+		Active:                 stats.Active,
+		RestartLSN:             stats.RestartLSN,
+		ConfirmedFlushLSN:      stats.ConfirmedFlushLSN,
+		RetainedWALBytes:       stats.RetainedWALBytes,
+		ConfirmedFlushLagBytes: stats.ConfirmedFlushLagBytes,
+		WALStatus:              stats.WALStatus,
+		LastCheckTime:          now.Format(time.RFC3339),
+	}
+
+	// Check if replication slot is inactive
+	if !stats.Active {
+		res.InactiveSince = now.Format(time.RFC3339)
+		// Keep first detection time
+		if previous != nil && previous.InactiveSince != "" {
+			res.InactiveSince = previous.InactiveSince
+		}
+	}
+
+	return res
+}
+
+func (r *PostgresqlPublicationReconciler) deleteReplicationSlotMetrics(instance *v1alpha1.PostgresqlPublication) {
+	labels := prometheus.Labels{"namespace": instance.Namespace, "name": instance.Name}
+
+	r.ReplicationSlotActive.DeletePartialMatch(labels)
+	r.ReplicationSlotRetainedWALBytes.DeletePartialMatch(labels)
+	r.ReplicationSlotConfirmedFlushLag.DeletePartialMatch(labels)
+}
+
+func (r *PostgresqlPublicationReconciler) updateInstance(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlPublication,
+) (bool, error) {
+	// Deep copy
+	oCopy := instance.DeepCopy()
+
+	// Add finalizer
+	controllerutil.AddFinalizer(instance, config.Finalizer)
+
+	// Check if replication slot name isn't set
+	if instance.Spec.ReplicationSlotName == "" {
+		// Set to publication name
+		instance.Spec.ReplicationSlotName = instance.Spec.Name
+	}
+
+	// Check if replication slot plugin isn't set
+	if instance.Spec.ReplicationSlotPlugin == "" {
+		// Set to default
+		instance.Spec.ReplicationSlotPlugin = DefaultReplicationSlotPlugin
+	}
+
+	// Check if CDC is enabled
+	if instance.Spec.CDC != nil {
+		// Check if schema isn't set
+		if instance.Spec.CDC.Schema == "" {
+			// Set to default
+			instance.Spec.CDC.Schema = defaultPGPublicSchemaName
+		}
+
+		// Check if replication user role name isn't set
+		if instance.Spec.CDC.ReplicationUser != nil && instance.Spec.CDC.ReplicationUser.RoleName == "" {
+			// Set to publication name
+			instance.Spec.CDC.ReplicationUser.RoleName = instance.Spec.Name + DefaultCDCReplicationUserSuffix
+		}
+	}
+
+	// Check if update is needed
+	if !reflect.DeepEqual(oCopy.ObjectMeta, instance.ObjectMeta) {
+		return true, r.Update(ctx, instance)
+	}
+
+	return false, nil
+}
+
+func (r *PostgresqlPublicationReconciler) manageDropPublication(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlPublication,
+) error {
+	// Get pg db
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// In case of not found => Can't delete => skip
+	if errors.IsNotFound(err) {
+		logger.Error(err, "can't delete publication because PostgresDatabase didn't exists anymore")
+
+		return nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// In case of not found => Can't delete => skip
+	if errors.IsNotFound(err) {
+		logger.Error(err, "can't delete database because PostgresEngineConfiguration didn't exists anymore")
+
+		return nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return err
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
+
+	// Get publication
+	pub, err := pg.GetPublication(ctx, pgDB.Status.Database, instance.Spec.Name)
+	if err != nil {
+		return err
+	}
+
+	// Check if publication is still present to delete it
+	if pub != nil {
+		// Drop publication
+		err = pg.DropPublication(ctx, pgDB.Status.Database, instance.Spec.Name)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if a CDC replication user have been created
+	if instance.Status.CDCReplicationUserRoleName != "" {
+		// Check if role still exists
+		exists, err := pg.IsRoleExist(ctx, instance.Status.CDCReplicationUserRoleName)
+		if err != nil {
+			return err
+		}
+
+		// Check if role is still present to delete it
+		if exists {
+			// Drop role and its privileges
+			err = pg.DropRoleAndDropAndChangeOwnedBy(ctx, instance.Status.CDCReplicationUserRoleName, pgDB.Status.Roles.Owner, pgDB.Status.Database)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Check if replication slot is defined
+	if instance.Spec.ReplicationSlotName != "" {
+		// Get replication slot
+		rep, err := pg.GetReplicationSlot(ctx, instance.Spec.ReplicationSlotName)
+		if err != nil {
+			return err
+		}
+
+		// Check if replication slot is still present to delete it
+		if rep != nil {
+			// Drop replication slot
+			err = pg.DropReplicationSlot(ctx, instance.Spec.ReplicationSlotName)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlPublicationReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlPublication,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.PublicationFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
 	}
 
 	// Return error
@@ -814,6 +2163,13 @@ func (r *PostgresqlPublicationReconciler) manageSuccess(
 	instance *v1alpha1.PostgresqlPublication,
 	originalPatch client.Patch,
 ) (reconcile.Result, error) {
+	// Compute replication slot check interval
+	// ? Note: Value have been checked in validate
+	dur := DefaultSlotMonitoringCheckInterval
+	if instance.Spec.SlotMonitoring != nil && instance.Spec.SlotMonitoring.CheckInterval != "" {
+		dur, _ = time.ParseDuration(instance.Spec.SlotMonitoring.CheckInterval)
+	}
+
 	// Update status
 	instance.Status.Message = ""
 	instance.Status.Ready = true
@@ -833,12 +2189,21 @@ func (r *PostgresqlPublicationReconciler) manageSuccess(
 
 	logger.Info("Reconcile done")
 
-	return reconcile.Result{}, nil
+	// Check if periodic checks are needed
+	if instance.Spec.SlotMonitoring == nil && instance.Spec.SlotSafeguard == nil {
+		return ctrl.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: dur, Requeue: true}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *PostgresqlPublicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.PostgresqlPublication{}).
+		// ? Note: Status updates are ignored as monitored values change at each check, periodic checks rely on requeue
+		For(
+			&v1alpha1.PostgresqlPublication{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
+		).
 		Complete(r)
 }
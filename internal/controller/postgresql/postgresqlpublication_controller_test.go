@@ -10,6 +10,7 @@ import (
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -152,7 +153,7 @@ var _ = Describe("PostgresqlPublication tests", func() {
 			// Checks
 			Expect(item.Status.Ready).To(BeFalse())
 			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
-			Expect(item.Status.Message).To(Equal("nothing is selected for publication (no all tables, no tables in schema, no tables)"))
+			Expect(item.Status.Message).To(Equal("nothing is selected for publication (no all tables, no tables in schema, no tables, no table selector)"))
 		})
 
 		It("should fail when all tables and tables in schema are provided", func() {
@@ -530,6 +531,59 @@ var _ = Describe("PostgresqlPublication tests", func() {
 			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
 			Expect(item.Status.Message).To(Equal("tables cannot have a columns list with an empty name or have a columns list with a table schema list enabled or an empty additional where"))
 		})
+
+		It("should fail when slot monitoring check interval isn't a valid duration", func() {
+			it := &postgresqlv1alpha1.PostgresqlPublication{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgpublicationName,
+					Namespace: pgpublicationNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlPublicationSpec{
+					Database: &common.CRLink{
+						Name:      pgdbName,
+						Namespace: pgdbNamespace,
+					},
+					Name:      pgpublicationPublicationName1,
+					AllTables: true,
+					SlotMonitoring: &postgresqlv1alpha1.PostgresqlPublicationSlotMonitoring{
+						CheckInterval: "fake",
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlPublication{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgpublicationName,
+						Namespace: pgpublicationNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.PublicationNoPhase {
+						return errors.New("pgpub hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("slot monitoring check interval must be a valid duration"))
+		})
 	})
 
 	Describe("Creation", func() {
@@ -5096,4 +5150,631 @@ var _ = Describe("PostgresqlPublication tests", func() {
 			}
 		})
 	})
+
+	Describe("Replication slot monitoring", func() {
+		It("should report replication slot health in status and metrics", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				AllTables: true,
+				SlotMonitoring: &postgresqlv1alpha1.PostgresqlPublicationSlotMonitoring{
+					CheckInterval:            "1s",
+					InactiveWarningThreshold: "1s",
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.ReplicationSlot).NotTo(BeNil())
+			Expect(item.Status.ReplicationSlot.Active).To(BeFalse())
+			Expect(item.Status.ReplicationSlot.RestartLSN).NotTo(BeEmpty())
+			Expect(item.Status.ReplicationSlot.InactiveSince).NotTo(BeEmpty())
+			Expect(item.Status.ReplicationSlot.LastCheckTime).NotTo(BeEmpty())
+
+			inactiveSince := item.Status.ReplicationSlot.InactiveSince
+			lastCheckTime := item.Status.ReplicationSlot.LastCheckTime
+
+			// Wait for a periodic check
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgpublicationName,
+						Namespace: pgpublicationNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.ReplicationSlot == nil || item.Status.ReplicationSlot.LastCheckTime == lastCheckTime {
+						return errors.New("pgpub replication slot hasn't been checked again by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// First inactivity detection must be kept
+			Expect(item.Status.ReplicationSlot.InactiveSince).To(Equal(inactiveSince))
+
+			// Check metrics
+			engine := pgecNamespace + "/" + pgecName
+			Expect(testutil.ToFloat64(
+				publicationReplicationSlotActive.WithLabelValues(pgpublicationNamespace, pgpublicationName, pgpublicationPublicationName1, engine),
+			)).To(Equal(0.0))
+			Expect(testutil.ToFloat64(
+				publicationReplicationSlotRetainedWALBytes.WithLabelValues(pgpublicationNamespace, pgpublicationName, pgpublicationPublicationName1, engine),
+			)).To(BeNumerically(">=", 0))
+		})
+
+		It("should pause publication when replication slot safeguard is triggered", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				AllTables: true,
+				SlotMonitoring: &postgresqlv1alpha1.PostgresqlPublicationSlotMonitoring{
+					CheckInterval: "1s",
+				},
+				SlotSafeguard: &postgresqlv1alpha1.PostgresqlPublicationSlotSafeguard{
+					MaxInactiveDuration: "1s",
+					Action:              postgresqlv1alpha1.SlotSafeguardPauseAction,
+				},
+			})
+
+			// Wait for pause
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgpublicationName,
+						Namespace: pgpublicationNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase != postgresqlv1alpha1.PublicationFailedPhase {
+						return errors.New("pgpub hasn't been paused by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Message).To(HavePrefix("replication slot safeguard triggered, publication is paused: inactive since"))
+			Expect(item.Status.SlotSafeguard).NotTo(BeNil())
+			Expect(item.Status.SlotSafeguard.Triggered).To(BeTrue())
+			Expect(item.Status.SlotSafeguard.Actions).To(HaveLen(1))
+			Expect(item.Status.SlotSafeguard.Actions[0].Action).To(Equal(postgresqlv1alpha1.SlotSafeguardPauseAction))
+
+			// Replication slot must be kept
+			data, err := getReplicationSlot(pgpublicationPublicationName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).NotTo(BeNil())
+		})
+
+		It("should recreate replication slot when replication slot safeguard is triggered", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				AllTables: true,
+				SlotMonitoring: &postgresqlv1alpha1.PostgresqlPublicationSlotMonitoring{
+					CheckInterval: "1s",
+				},
+				SlotSafeguard: &postgresqlv1alpha1.PostgresqlPublicationSlotSafeguard{
+					MaxInactiveDuration: "1s",
+					Action:              postgresqlv1alpha1.SlotSafeguardRecreateAction,
+				},
+			})
+
+			// Wait for recreation
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgpublicationName,
+						Namespace: pgpublicationNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.SlotSafeguard == nil || len(item.Status.SlotSafeguard.Actions) == 0 {
+						return errors.New("pgpub replication slot hasn't been recreated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.SlotSafeguard.Actions[0].Action).To(Equal(postgresqlv1alpha1.SlotSafeguardRecreateAction))
+			Expect(item.Status.SlotSafeguard.Actions[0].Reason).To(HavePrefix("inactive since"))
+
+			// Replication slot must exist
+			data, err := getReplicationSlot(pgpublicationPublicationName1)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(data).To(Equal(&replicationSlotResult{
+					SlotName: pgpublicationPublicationName1,
+					Plugin:   DefaultReplicationSlotPlugin,
+					Database: pgdbDBName,
+				}))
+			}
+		})
+	})
+
+	Describe("CDC", func() {
+		It("should fail when cdc schema isn't listed in tables in schema", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TablesInSchema: []string{pgdbSchemaName1},
+				CDC: &postgresqlv1alpha1.PostgresqlPublicationCDC{
+					SignalingTableName: "debezium_signal",
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("cdc schema must be listed in tables in schema"))
+		})
+
+		It("should create cdc tables, replica identities and replication user", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+				CDC: &postgresqlv1alpha1.PostgresqlPublicationCDC{
+					SignalingTableName: "debezium_signal",
+					HeartbeatTableName: "debezium_heartbeat",
+					ReplicaIdentities: []*postgresqlv1alpha1.PostgresqlPublicationCDCReplicaIdentity{
+						{TableName: "public.fake", ReplicaIdentity: postgresqlv1alpha1.FullPublicationReplicaIdentity},
+					},
+					ReplicationUser: &postgresqlv1alpha1.PostgresqlPublicationCDCReplicationUser{
+						GeneratedSecretName: pgpublicationCDCSecretName,
+					},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.CDCReplicationUserRoleName).To(Equal(pgpublicationPublicationName1 + DefaultCDCReplicationUserSuffix))
+
+			// Check cdc tables owner
+			owner, err := getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, "debezium_signal")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+			owner, err = getTableOwnerInSchema(pgdbDBName, pgPublicSchemaName, "debezium_heartbeat")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(pgdb.Status.Roles.Owner))
+
+			// Check replica identity
+			full, err := rawSQLQueryBoolInDB(`SELECT relreplident = 'f' FROM pg_class WHERE relname = 'fake'`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(full).To(BeTrue())
+
+			// Check that cdc tables are published
+			details, err := getPublicationTableDetails(item.Status.Name)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(details).To(HaveLen(3))
+			}
+
+			// Check replication user
+			replication, err := rawSQLQueryBoolInDB(
+				fmt.Sprintf(`SELECT rolreplication FROM pg_roles WHERE rolname = '%s'`, item.Status.CDCReplicationUserRoleName),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replication).To(BeTrue())
+
+			canSelect, err := rawSQLQueryBoolInDB(
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.fake', 'SELECT')`, item.Status.CDCReplicationUserRoleName),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(canSelect).To(BeTrue())
+
+			// Check write privileges on cdc tables only
+			username := item.Status.CDCReplicationUserRoleName
+			for q, v := range map[string]bool{
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.fake', 'INSERT')`, username):               false,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_signal', 'SELECT')`, username):    true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_signal', 'INSERT')`, username):    true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_signal', 'UPDATE')`, username):    true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_signal', 'DELETE')`, username):    true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_heartbeat', 'INSERT')`, username): true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_heartbeat', 'UPDATE')`, username): true,
+				fmt.Sprintf(`SELECT has_table_privilege('%s', 'public.debezium_heartbeat', 'DELETE')`, username): true,
+			} {
+				res, err := rawSQLQueryBoolInDB(q)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(v), q)
+			}
+
+			// Check secret
+			sec, err := getSecret(ctx, k8sClient, pgpublicationCDCSecretName, pgpublicationNamespace)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(string(sec.Data[SecretMainKeyLogin])).To(Equal(item.Status.CDCReplicationUserRoleName))
+				Expect(string(sec.Data[SecretMainKeyDatabase])).To(Equal(pgdbDBName))
+				Expect(sec.Data[SecretMainKeyPassword]).NotTo(BeEmpty())
+				Expect(sec.Data[SecretMainKeyPostgresURL]).NotTo(BeEmpty())
+			}
+		})
+	})
+
+	Describe("Replica identity", func() {
+		It("should fail when a replica identity is set multiple times with different values", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{
+					{TableName: "fake", ReplicaIdentity: postgresqlv1alpha1.FullPublicationReplicaIdentity},
+				},
+				CDC: &postgresqlv1alpha1.PostgresqlPublicationCDC{
+					ReplicaIdentities: []*postgresqlv1alpha1.PostgresqlPublicationCDCReplicaIdentity{
+						{TableName: "public.fake", ReplicaIdentity: postgresqlv1alpha1.DefaultPublicationReplicaIdentity},
+					},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("replica identity for table public.fake is set multiple times with different values"))
+		})
+
+		It("should set replica identity and report tables without usable replica identity", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{
+					{TableName: "fake", ReplicaIdentity: postgresqlv1alpha1.FullPublicationReplicaIdentity},
+					{TableName: "fake2"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationCreatedPhase))
+			Expect(item.Status.TablesWithoutReplicaIdentity).To(Equal([]string{"public.fake2"}))
+
+			full, err := rawSQLQueryBoolInDB(`SELECT relreplident = 'f' FROM pg_class WHERE relname = 'fake'`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(full).To(BeTrue())
+		})
+
+		It("should set replica identity using an index", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rawSQLQuery(`CREATE UNIQUE INDEX fake_id_idx ON public.fake (id)`)).To(Succeed())
+			Expect(rawSQLQuery(`ALTER TABLE public.fake ALTER COLUMN id SET NOT NULL`)).To(Succeed())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{
+					{TableName: "fake", ReplicaIdentity: "USING INDEX fake_id_idx"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.TablesWithoutReplicaIdentity).To(BeEmpty())
+
+			indexed, err := rawSQLQueryBoolInDB(`SELECT indisreplident FROM pg_index WHERE indexrelid = 'public.fake_id_idx'::regclass`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(indexed).To(BeTrue())
+		})
+
+		It("shouldn't report tables when update and delete aren't published", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+				WithParameters: &postgresqlv1alpha1.PostgresqlPublicationWith{
+					Publish: "insert",
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.TablesWithoutReplicaIdentity).To(BeEmpty())
+		})
+	})
+
+	Describe("Table selector", func() {
+		It("should fail when table selector is set with tables in schema", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TablesInSchema: []string{pgPublicSchemaName},
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					Include: []string{"public.*"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("table selector cannot be set with all tables or tables in schema"))
+		})
+
+		It("should fail when table selector doesn't have any include pattern or comment filter", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					Exclude: []string{"public.*"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("table selector must have include patterns or a comment filter"))
+		})
+
+		It("should fail when a table selector regex pattern is invalid", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					PatternType: postgresqlv1alpha1.RegexTableSelectorPatternType,
+					Include:     []string{"public.(fake"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(HavePrefix("table selector pattern public.(fake is invalid"))
+		})
+
+		It("should publish tables matching include and exclude glob patterns", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					Include: []string{"public.fake*"},
+					Exclude: []string{"public.fake2"},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationCreatedPhase))
+			Expect(item.Status.DiscoveredTables).To(Equal([]string{"public.fake"}))
+
+			details, err := getPublicationTableDetails(item.Status.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(details).To(HaveLen(1))
+			Expect(details[0].TableName).To(Equal("fake"))
+		})
+
+		It("should publish tables selected by comment", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rawSQLQuery(`COMMENT ON TABLE public.fake2 IS 'cdc:enabled'`)).To(Succeed())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					CommentContains: "cdc:enabled",
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.DiscoveredTables).To(Equal([]string{"public.fake2"}))
+		})
+
+		It("should create an empty publication and add newly matching tables", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				TableSelector: &postgresqlv1alpha1.PostgresqlPublicationTableSelector{
+					PatternType: postgresqlv1alpha1.RegexTableSelectorPatternType,
+					Include:     []string{`public\.fake\d?`},
+				},
+				SlotMonitoring: &postgresqlv1alpha1.PostgresqlPublicationSlotMonitoring{
+					CheckInterval: "1s",
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.DiscoveredTables).To(BeEmpty())
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			var details []*PublicationTableDetail
+
+			Eventually(
+				func() error {
+					details, err = getPublicationTableDetails(item.Status.Name)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check hasn't been updated
+					if len(details) != 2 {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Drop a table from selection
+			Expect(rawSQLQuery(`ALTER TABLE public.fake2 RENAME TO other`)).To(Succeed())
+
+			Eventually(
+				func() error {
+					details, err = getPublicationTableDetails(item.Status.Name)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check hasn't been updated
+					if len(details) != 1 {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			Expect(details[0].TableName).To(Equal("fake"))
+		})
+	})
+
+	Describe("Owner", func() {
+		It("should fail when user role owner type is set without any user role", func() {
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+				Owner: &postgresqlv1alpha1.PostgresqlPublicationOwner{
+					Type: postgresqlv1alpha1.UserRolePublicationOwnerType,
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.PublicationFailedPhase))
+			Expect(item.Status.Message).To(Equal("owner user role must be set with UserRole owner type"))
+		})
+
+		It("should be ok to set engine configuration user as owner", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+				Owner: &postgresqlv1alpha1.PostgresqlPublicationOwner{
+					Type: postgresqlv1alpha1.EngineConfigurationUserPublicationOwnerType,
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Owner).To(Equal(postgresUser))
+
+			owned, err := rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT pg_get_userbyid(pubowner) = '%s' FROM pg_publication WHERE pubname = '%s'`, postgresUser, pgpublicationPublicationName1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(BeTrue())
+		})
+
+		It("should be ok to set a user role as owner", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+			// Create user role
+			setupPGURImportSecret()
+			setupProvidedPGUR()
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			item := setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+				Owner: &postgresqlv1alpha1.PostgresqlPublicationOwner{
+					Type:     postgresqlv1alpha1.UserRolePublicationOwnerType,
+					UserRole: &common.CRLink{Name: pgurName, Namespace: pgurNamespace},
+				},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Owner).To(Equal(pgurImportUsername))
+
+			owned, err := rawSQLQueryBoolInDB(fmt.Sprintf(`SELECT pg_get_userbyid(pubowner) = '%s' FROM pg_publication WHERE pubname = '%s'`, pgurImportUsername, pgpublicationPublicationName1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(BeTrue())
+		})
+	})
 })
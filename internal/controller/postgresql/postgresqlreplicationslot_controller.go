@@ -0,0 +1,601 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/config"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Two phase option have been added in PostgreSQL 14.
+	replicationSlotTwoPhaseMinVersion = 140000
+	// Failover option have been added in PostgreSQL 17.
+	replicationSlotFailoverMinVersion = 170000
+)
+
+// PostgresqlReplicationSlotReconciler reconciles a PostgresqlReplicationSlot object.
+type PostgresqlReplicationSlotReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlreplicationslots,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlreplicationslots/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlreplicationslots/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlReplicationSlot object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlReplicationSlotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlReplicationSlot")
+
+	// Fetch the PostgresqlReplicationSlot instance
+	instance := &v1alpha1.PostgresqlReplicationSlot{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlReplicationSlotReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Deletion case
+	if !instance.GetDeletionTimestamp().IsZero() { //nolint:wsl
+		// Deletion detected
+
+		// Check if drop on delete is enabled and replication slot have been created
+		if instance.Spec.DropOnDelete && instance.Status.Name != "" {
+			// Delete replication slot
+			err := r.manageDropReplicationSlot(ctx, reqLogger, instance)
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+		}
+
+		// Remove finalizer
+		controllerutil.RemoveFinalizer(instance, config.Finalizer)
+
+		// Update CR
+		err := r.Update(ctx, instance)
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		reqLogger.Info("Successfully deleted")
+		// Stop reconcile
+		return reconcile.Result{}, nil
+	}
+
+	// Creation / Update case
+
+	// Validate
+	err := r.validate(instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Find linked resources
+	pgEngCfg, pgDB, err := r.findLinkedResources(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres database is ready before continue but only if it is the first time
+	// If not, requeue event
+	if pgDB != nil && instance.Status.Phase == v1alpha1.ReplicationSlotNoPhase && !pgDB.Status.Ready {
+		reqLogger.Info("PostgresqlDatabase not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlDatabase isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Check that postgres engine configuration is ready before continue but only if it is the first time
+	// If not, requeue event
+	if instance.Status.Phase == v1alpha1.ReplicationSlotNoPhase && !pgEngCfg.Status.Ready {
+		reqLogger.Info("PostgresqlEngineConfiguration not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Add finalizer and default values
+	updated, err := r.updateInstance(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if it has been updated in order to stop this reconcile loop here for the moment
+	if updated {
+		return ctrl.Result{}, nil
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg)
+
+	// Compute database
+	database := ""
+	if pgDB != nil {
+		database = pgDB.Status.Database
+	}
+
+	// Check replication slot changes
+	// ? Note: This is done after defaulting values in order to compare them
+	err = r.validateUpgrade(instance, database)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check server support for options
+	err = r.checkServerSupport(ctx, instance, pg)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Get replication slot
+	replicationSlotResult, err := pg.GetReplicationSlot(ctx, instance.Spec.Name)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check if replication slot hasn't been found in database
+	if replicationSlotResult == nil {
+		// Create it
+		err = pg.CreateReplicationSlot(ctx, database, instance.Spec.Name, instance.Spec.Plugin, &postgres.ReplicationSlotOptions{
+			Physical:           instance.Spec.Type == v1alpha1.PhysicalReplicationSlotType,
+			ImmediatelyReserve: instance.Spec.ImmediatelyReserve,
+			TwoPhase:           instance.Spec.TwoPhase,
+			Failover:           instance.Spec.Failover,
+		})
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "Processing", "Replication slot %s created", instance.Spec.Name)
+	} else { //nolint:wsl
+		// Update isn't possible in PG
+		// Here we decide to check and fail if already exists with another configuration
+		//
+
+		// Other type case
+		if (replicationSlotResult.SlotType == postgres.PhysicalReplicationSlotType) != (instance.Spec.Type == v1alpha1.PhysicalReplicationSlotType) {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("replication slot with the same name already exists with another type"))
+		}
+
+		// Logical case
+		if instance.Spec.Type == v1alpha1.LogicalReplicationSlotType {
+			// Other database case
+			if replicationSlotResult.Database != database {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("replication slot with the same name already exists for another database"))
+			}
+
+			// Other plugin case
+			if replicationSlotResult.Plugin != instance.Spec.Plugin {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("replication slot with the same name already exists with another plugin"))
+			}
+		}
+	}
+
+	// Get replication slot stats
+	stats, err := pg.GetReplicationSlotStats(ctx, instance.Spec.Name)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Save health
+	if stats != nil {
+		instance.Status.ReplicationSlot = buildReplicationSlotHealthStatus(stats, instance.Status.ReplicationSlot, time.Now().UTC())
+	}
+
+	// Save data
+	instance.Status.Name = instance.Spec.Name
+	instance.Status.Type = instance.Spec.Type
+	instance.Status.Plugin = instance.Spec.Plugin
+	instance.Status.Database = database
+
+	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+}
+
+func (r *PostgresqlReplicationSlotReconciler) findLinkedResources(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+) (*v1alpha1.PostgresqlEngineConfiguration, *v1alpha1.PostgresqlDatabase, error) {
+	// Check if engine configuration is directly linked
+	if instance.Spec.EngineConfiguration != nil {
+		pgEngCfg, err := utils.FindPgEngineCfgFromLink(ctx, r.Client, instance.Spec.EngineConfiguration, instance.Namespace)
+
+		return pgEngCfg, nil, err
+	}
+
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pgEngCfg, pgDB, nil
+}
+
+func (*PostgresqlReplicationSlotReconciler) checkServerSupport(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+	pg postgres.PG,
+) error {
+	// Check if there isn't any option depending on version
+	if !instance.Spec.TwoPhase && !instance.Spec.Failover {
+		return nil
+	}
+
+	// Get server version
+	version, err := pg.GetServerVersionNum(ctx)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check two phase
+	if instance.Spec.TwoPhase && version < replicationSlotTwoPhaseMinVersion {
+		return errors.NewBadRequest(fmt.Sprintf("two phase replication slots require PostgreSQL 14 or later, server version is %d", version))
+	}
+
+	// Check failover
+	if instance.Spec.Failover && version < replicationSlotFailoverMinVersion {
+		return errors.NewBadRequest(fmt.Sprintf("failover replication slots require PostgreSQL 17 or later, server version is %d", version))
+	}
+
+	return nil
+}
+
+func (*PostgresqlReplicationSlotReconciler) validate(
+	instance *v1alpha1.PostgresqlReplicationSlot,
+) error {
+	// Save spec for easy use
+	spec := instance.Spec
+	// Save status for easy use
+	status := instance.Status
+
+	// Check name
+	if spec.Name == "" {
+		return errors.NewBadRequest("name must have a value")
+	}
+
+	// Check links
+	if spec.Database == nil && spec.EngineConfiguration == nil {
+		return errors.NewBadRequest("database or engine configuration must be set")
+	}
+
+	if spec.Database != nil && spec.EngineConfiguration != nil {
+		return errors.NewBadRequest("database and engine configuration cannot be set together")
+	}
+
+	// Check physical case
+	if spec.Type == v1alpha1.PhysicalReplicationSlotType {
+		if spec.Plugin != "" || spec.TwoPhase || spec.Failover {
+			return errors.NewBadRequest("plugin, two phase and failover can only be set on logical replication slots")
+		}
+	} else {
+		if spec.Database == nil {
+			return errors.NewBadRequest("logical replication slots must be linked to a database")
+		}
+
+		if spec.ImmediatelyReserve {
+			return errors.NewBadRequest("immediately reserve can only be set on physical replication slots")
+		}
+	}
+
+	// Check check interval
+	if spec.CheckInterval != "" {
+		_, err := time.ParseDuration(spec.CheckInterval)
+		// Check error
+		if err != nil {
+			return errors.NewBadRequest("check interval must be a valid duration")
+		}
+	}
+
+	// Check name change
+	if status.Name != "" && status.Name != spec.Name {
+		return errors.NewBadRequest("cannot change replication slot name on an upgrade")
+	}
+
+	// Default
+	return nil
+}
+
+func (*PostgresqlReplicationSlotReconciler) validateUpgrade(
+	instance *v1alpha1.PostgresqlReplicationSlot,
+	database string,
+) error {
+	// Save status for easy use
+	status := instance.Status
+
+	// Check if replication slot haven't been created yet
+	if status.Name == "" {
+		return nil
+	}
+
+	// Check type change
+	if status.Type != instance.Spec.Type {
+		return errors.NewBadRequest("cannot change replication slot type on an upgrade")
+	}
+
+	// Check plugin change
+	if status.Plugin != instance.Spec.Plugin {
+		return errors.NewBadRequest("cannot change replication slot plugin on an upgrade")
+	}
+
+	// Check database change
+	if status.Database != database {
+		return errors.NewBadRequest("cannot change replication slot database on an upgrade")
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlReplicationSlotReconciler) updateInstance(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+) (bool, error) {
+	// Deep copy
+	oCopy := instance.DeepCopy()
+
+	// Add finalizer
+	controllerutil.AddFinalizer(instance, config.Finalizer)
+
+	// Check if type isn't set
+	if instance.Spec.Type == "" {
+		// Set to default
+		instance.Spec.Type = v1alpha1.LogicalReplicationSlotType
+	}
+
+	// Check if plugin isn't set on a logical replication slot
+	if instance.Spec.Type == v1alpha1.LogicalReplicationSlotType && instance.Spec.Plugin == "" {
+		// Set to default
+		instance.Spec.Plugin = DefaultReplicationSlotPlugin
+	}
+
+	// Check if update is needed
+	if !reflect.DeepEqual(oCopy.ObjectMeta, instance.ObjectMeta) || !reflect.DeepEqual(oCopy.Spec, instance.Spec) {
+		return true, r.Update(ctx, instance)
+	}
+
+	return false, nil
+}
+
+func (r *PostgresqlReplicationSlotReconciler) manageDropReplicationSlot(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+) error {
+	// Find linked resources
+	pgEngCfg, _, err := r.findLinkedResources(ctx, instance)
+	// Check error
+	if err != nil {
+		// Check if it is a not found error
+		if errors.IsNotFound(err) {
+			// Ignore as engine or database is already deleted
+			return nil
+		}
+
+		return err
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
+
+	// Get replication slot
+	rep, err := pg.GetReplicationSlot(ctx, instance.Status.Name)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if it exists
+	if rep != nil {
+		err = pg.DropReplicationSlot(ctx, instance.Status.Name)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlReplicationSlotReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.ReplicationSlotFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlReplicationSlotReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlReplicationSlot,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Compute check interval
+	// ? Note: Value have been checked in validate
+	dur := DefaultSlotMonitoringCheckInterval
+	if instance.Spec.CheckInterval != "" {
+		dur, _ = time.ParseDuration(instance.Spec.CheckInterval)
+	}
+
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = v1alpha1.ReplicationSlotCreatedPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return reconcile.Result{RequeueAfter: dur, Requeue: true}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlReplicationSlotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// ? Note: Status updates are ignored as monitored values change at each check, periodic checks rely on requeue
+		For(
+			&v1alpha1.PostgresqlReplicationSlot{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
+		).
+		Complete(r)
+}
@@ -0,0 +1,234 @@
+package postgresql
+
+import (
+	gerrors "errors"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("PostgresqlReplicationSlot tests", func() {
+	AfterEach(cleanupFunction)
+
+	Describe("Spec error", func() {
+		It("should fail when database and engine configuration are both missing", func() {
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name: pgreplicationslotSlotName,
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotFailedPhase))
+			Expect(item.Status.Message).To(Equal("database or engine configuration must be set"))
+		})
+
+		It("should fail when a logical replication slot isn't linked to a database", func() {
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:                pgreplicationslotSlotName,
+				EngineConfiguration: &common.CRLink{Name: pgecName, Namespace: pgecNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotFailedPhase))
+			Expect(item.Status.Message).To(Equal("logical replication slots must be linked to a database"))
+		})
+
+		It("should fail when a physical replication slot has a plugin", func() {
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:                pgreplicationslotSlotName,
+				Type:                postgresqlv1alpha1.PhysicalReplicationSlotType,
+				Plugin:              DefaultReplicationSlotPlugin,
+				EngineConfiguration: &common.CRLink{Name: pgecName, Namespace: pgecNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotFailedPhase))
+			Expect(item.Status.Message).To(Equal("plugin, two phase and failover can only be set on logical replication slots"))
+		})
+	})
+
+	Describe("Creation", func() {
+		It("should be ok to create a logical replication slot", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:     pgreplicationslotSlotName,
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.Name).To(Equal(pgreplicationslotSlotName))
+			Expect(item.Status.Type).To(Equal(postgresqlv1alpha1.LogicalReplicationSlotType))
+			Expect(item.Status.Plugin).To(Equal(DefaultReplicationSlotPlugin))
+			Expect(item.Status.Database).To(Equal(pgdbDBName))
+			Expect(item.Spec.Type).To(Equal(postgresqlv1alpha1.LogicalReplicationSlotType))
+			Expect(item.Spec.Plugin).To(Equal(DefaultReplicationSlotPlugin))
+			Expect(item.Status.ReplicationSlot).NotTo(BeNil())
+			Expect(item.Status.ReplicationSlot.Active).To(BeFalse())
+
+			data, err := getReplicationSlot(pgreplicationslotSlotName)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(data).To(Equal(&replicationSlotResult{
+					SlotName: pgreplicationslotSlotName,
+					Plugin:   DefaultReplicationSlotPlugin,
+					Database: pgdbDBName,
+				}))
+			}
+		})
+
+		It("should be ok to create and drop a physical replication slot", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:                pgreplicationslotSlotName,
+				Type:                postgresqlv1alpha1.PhysicalReplicationSlotType,
+				ImmediatelyReserve:  true,
+				DropOnDelete:        true,
+				EngineConfiguration: &common.CRLink{Name: pgecName, Namespace: pgecNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.Type).To(Equal(postgresqlv1alpha1.PhysicalReplicationSlotType))
+			Expect(item.Status.Plugin).To(Equal(""))
+			Expect(item.Status.Database).To(Equal(""))
+
+			data, err := getReplicationSlot(pgreplicationslotSlotName)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(data).To(Equal(&replicationSlotResult{
+					SlotName: pgreplicationslotSlotName,
+				}))
+			}
+
+			// Delete
+			Expect(k8sClient.Delete(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgreplicationslotName,
+						Namespace: pgreplicationslotNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						// Check if it is a not found error
+						if apimachineryErrors.IsNotFound(err) {
+							return nil
+						}
+
+						return err
+					}
+
+					return gerrors.New("object still present")
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			data, err = getReplicationSlot(pgreplicationslotSlotName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(BeNil())
+		})
+
+		It("should fail when a replication slot already exists for another database", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create slot in another database
+			Expect(createReplicationSlotInMainDB(pgreplicationslotSlotName, DefaultReplicationSlotPlugin)).To(Succeed())
+
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:     pgreplicationslotSlotName,
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotFailedPhase))
+			Expect(item.Status.Message).To(Equal("replication slot with the same name already exists for another database"))
+		})
+	})
+
+	Describe("Update", func() {
+		It("should fail when plugin is changed", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupPGReplicationSlot(postgresqlv1alpha1.PostgresqlReplicationSlotSpec{
+				Name:     pgreplicationslotSlotName,
+				Database: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotCreatedPhase))
+
+			// Update
+			item.Spec.Plugin = "test_decoding"
+			// Update
+			err := k8sClient.Update(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedItem := &postgresqlv1alpha1.PostgresqlReplicationSlot{}
+
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      item.Name,
+						Namespace: item.Namespace,
+					}, updatedItem)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if updatedItem.Status.Phase == item.Status.Phase {
+						return gerrors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(updatedItem.Status.Ready).To(BeFalse())
+			Expect(updatedItem.Status.Phase).To(Equal(postgresqlv1alpha1.ReplicationSlotFailedPhase))
+			Expect(updatedItem.Status.Message).To(Equal("cannot change replication slot plugin on an upgrade"))
+			Expect(updatedItem.Status.Plugin).To(Equal(DefaultReplicationSlotPlugin))
+
+			// Slot must be untouched
+			data, err := getReplicationSlot(pgreplicationslotSlotName)
+			if Expect(err).NotTo(HaveOccurred()) {
+				Expect(data).To(Equal(&replicationSlotResult{
+					SlotName: pgreplicationslotSlotName,
+					Plugin:   DefaultReplicationSlotPlugin,
+					Database: pgdbDBName,
+				}))
+			}
+		})
+	})
+})
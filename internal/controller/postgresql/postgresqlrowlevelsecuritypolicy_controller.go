@@ -0,0 +1,659 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/config"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+)
+
+// PostgresqlRowLevelSecurityPolicyReconciler reconciles a PostgresqlRowLevelSecurityPolicy object.
+type PostgresqlRowLevelSecurityPolicyReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlrowlevelsecuritypolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlrowlevelsecuritypolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlrowlevelsecuritypolicies/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlRowLevelSecurityPolicy object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlRowLevelSecurityPolicy")
+
+	// Fetch the PostgresqlRowLevelSecurityPolicy instance
+	instance := &v1alpha1.PostgresqlRowLevelSecurityPolicy{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Deletion case
+	if !instance.GetDeletionTimestamp().IsZero() { //nolint:wsl
+		// Deletion detected
+
+		// Delete policy
+		err := r.manageDropPolicy(ctx, reqLogger, instance)
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		// Remove finalizer
+		controllerutil.RemoveFinalizer(instance, config.Finalizer)
+
+		// Update CR
+		err = r.Update(ctx, instance)
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		reqLogger.Info("Successfully deleted")
+		// Stop reconcile
+		return reconcile.Result{}, nil
+	}
+
+	// Creation / Update case
+
+	// Validate
+	err := r.validate(instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres database is ready before continue but only if it is the first time
+	// If not, requeue event
+	if instance.Status.Phase == v1alpha1.RowLevelSecurityPolicyNoPhase && !pgDB.Status.Ready {
+		reqLogger.Info("PostgresqlDatabase not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlDatabase isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that postgres engine configuration is ready before continue but only if it is the first time
+	// If not, requeue event
+	if instance.Status.Phase == v1alpha1.RowLevelSecurityPolicyNoPhase && !pgEngCfg.Status.Ready {
+		reqLogger.Info("PostgresqlEngineConfiguration not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Add finalizer and default values
+	updated, err := r.updateInstance(ctx, instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if it has been updated in order to stop this reconcile loop here for the moment
+	if updated {
+		return ctrl.Result{}, nil
+	}
+
+	// Calculate hash for status (this time is to update it in status)
+	hash, err := utils.CalculateHash(instance.Spec)
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(err))
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg)
+
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Manage row level security on table
+	err = r.manageTableRowLevelSecurity(ctx, reqLogger, instance, pg, pgDB)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check if policy have been moved to another table
+	if instance.Status.Name != "" && (instance.Status.Schema != spec.Schema || instance.Status.Table != spec.Table) {
+		reqLogger.Info("Policy table have been changed, dropping old policy")
+
+		// Drop old policy
+		err = pg.DropPolicy(ctx, pgDB.Status.Database, instance.Status.Schema, instance.Status.Table, instance.Status.Name)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		// Clean status to start from scratch
+		instance.Status.Name = ""
+		instance.Status.Using = nil
+		instance.Status.WithCheck = nil
+	}
+
+	// Compute name to search
+	nameToSearch := instance.Status.Name
+	// Check
+	if nameToSearch == "" {
+		// ? This is done to recover the first creation with an existing policy with the same name
+		nameToSearch = spec.Name
+	}
+
+	// Get policy
+	policyRes, err := pg.GetPolicy(ctx, pgDB.Status.Database, spec.Schema, spec.Table, nameToSearch)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Build wanted policy
+	wanted := r.buildWantedPolicy(instance, pgDB)
+
+	// Check if policy haven't been found
+	if policyRes == nil {
+		// Create case
+		reqLogger.Info("Policy creation case detected")
+
+		err = pg.CreatePolicy(ctx, pgDB.Status.Database, wanted)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+	} else {
+		// Check if policy has to be renamed
+		if nameToSearch != spec.Name {
+			reqLogger.Info("Policy rename case detected")
+
+			err = pg.RenamePolicy(ctx, pgDB.Status.Database, spec.Schema, spec.Table, nameToSearch, spec.Name)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+		}
+
+		// Check if policy must be recreated because command, type or expression removal cannot be altered
+		if r.isRecreateNecessary(policyRes, wanted) {
+			reqLogger.Info("Policy cannot be altered, recreate need to be done")
+
+			// Drop policy
+			err = pg.DropPolicy(ctx, pgDB.Status.Database, spec.Schema, spec.Table, spec.Name)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+
+			// Create policy
+			err = pg.CreatePolicy(ctx, pgDB.Status.Database, wanted)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+		} else if hash != instance.Status.Hash {
+			reqLogger.Info("Specs are different, update need to be done")
+
+			err = pg.AlterPolicy(ctx, pgDB.Status.Database, wanted)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+		} else if r.isReconcileOnPGNecessary(instance, policyRes, wanted) {
+			reqLogger.Info("PG state have been changed but not via operator, update need to be done")
+
+			err = pg.AlterPolicy(ctx, pgDB.Status.Database, wanted)
+			// Check error
+			if err != nil {
+				return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+			}
+		}
+	}
+
+	// Get policy as stored in database to save expressions
+	// ? Note: PostgreSQL is rewriting expressions so this is the only way to detect drift on them
+	policyRes, err = pg.GetPolicy(ctx, pgDB.Status.Database, spec.Schema, spec.Table, spec.Name)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if policy haven't been found
+	if policyRes == nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewInternalError(fmt.Errorf("policy %s not found after creation or update", spec.Name)))
+	}
+
+	// Save data
+	instance.Status.Name = spec.Name
+	instance.Status.Schema = spec.Schema
+	instance.Status.Table = spec.Table
+	instance.Status.Using = policyRes.Using
+	instance.Status.WithCheck = policyRes.WithCheck
+	// Save hash in status
+	instance.Status.Hash = hash
+
+	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) manageTableRowLevelSecurity(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	pg postgres.PG,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) error {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Get table row level security
+	tableRLS, err := pg.GetTableRowLevelSecurity(ctx, pgDB.Status.Database, spec.Schema, spec.Table)
+	// Check error
+	if err != nil {
+		return err
+	}
+	// Check if table haven't been found
+	if tableRLS == nil {
+		return errors.NewBadRequest(fmt.Sprintf("table %s.%s not found in database", spec.Schema, spec.Table))
+	}
+
+	// Check if row level security must be enabled
+	if !tableRLS.Enabled {
+		logger.Info("Enabling row level security on table")
+
+		err = pg.EnableRowLevelSecurity(ctx, pgDB.Status.Database, spec.Schema, spec.Table)
+		// Check error
+		if err != nil {
+			return err
+		}
+
+		r.Recorder.Eventf(instance, "Normal", "RowLevelSecurityEnabled", "Row level security enabled on table %s.%s", spec.Schema, spec.Table)
+	}
+
+	// Check if force row level security must be changed
+	if tableRLS.Forced != spec.ForceRowLevelSecurity {
+		logger.Info("Changing force row level security on table")
+
+		err = pg.ForceRowLevelSecurity(ctx, pgDB.Status.Database, spec.Schema, spec.Table, spec.ForceRowLevelSecurity)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (*PostgresqlRowLevelSecurityPolicyReconciler) buildWantedPolicy(
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	pgDB *v1alpha1.PostgresqlDatabase,
+) *postgres.Policy {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Init roles
+	roles := []string{}
+	roles = append(roles, spec.Roles...)
+
+	// Add database roles
+	for _, it := range spec.DatabaseRoles {
+		switch it {
+		case v1alpha1.ReaderPolicyDatabaseRole:
+			roles = append(roles, pgDB.Status.Roles.Reader)
+		case v1alpha1.WriterPolicyDatabaseRole:
+			roles = append(roles, pgDB.Status.Roles.Writer)
+		}
+	}
+
+	return &postgres.Policy{
+		Name:       spec.Name,
+		Schema:     spec.Schema,
+		Table:      spec.Table,
+		Command:    string(spec.Command),
+		Permissive: !spec.Restrictive,
+		Roles:      lo.Uniq(roles),
+		Using:      spec.Using,
+		WithCheck:  spec.WithCheck,
+	}
+}
+
+func (*PostgresqlRowLevelSecurityPolicyReconciler) isRecreateNecessary(
+	policyRes *postgres.Policy,
+	wanted *postgres.Policy,
+) bool {
+	// Command and type cannot be altered
+	if policyRes.Command != wanted.Command || policyRes.Permissive != wanted.Permissive {
+		return true
+	}
+
+	// Expressions cannot be removed with an alter
+	return (wanted.Using == nil && policyRes.Using != nil) ||
+		(wanted.WithCheck == nil && policyRes.WithCheck != nil)
+}
+
+func (*PostgresqlRowLevelSecurityPolicyReconciler) isReconcileOnPGNecessary(
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	policyRes *postgres.Policy,
+	wanted *postgres.Policy,
+) bool {
+	// Compute wanted roles
+	wantedRoles := wanted.Roles
+	// Check if there isn't any role
+	if len(wantedRoles) == 0 {
+		// Public is set by PG
+		wantedRoles = []string{postgres.PublicPolicyRole}
+	}
+
+	// Check roles
+	r1, r2 := lo.Difference(wantedRoles, policyRes.Roles)
+	if len(r1) != 0 || len(r2) != 0 {
+		return true
+	}
+
+	// Check expressions against saved ones
+	return lo.FromPtr(instance.Status.Using) != lo.FromPtr(policyRes.Using) ||
+		lo.FromPtr(instance.Status.WithCheck) != lo.FromPtr(policyRes.WithCheck)
+}
+
+func (*PostgresqlRowLevelSecurityPolicyReconciler) validate(
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+) error {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Check name
+	if spec.Name == "" {
+		return errors.NewBadRequest("name must have a value")
+	}
+
+	// Check table
+	if spec.Table == "" {
+		return errors.NewBadRequest("table must have a value")
+	}
+
+	// Check that an expression is set
+	if spec.Using == nil && spec.WithCheck == nil {
+		return errors.NewBadRequest("using or with check expression must be set")
+	}
+
+	// Check empty expressions
+	if (spec.Using != nil && *spec.Using == "") || (spec.WithCheck != nil && *spec.WithCheck == "") {
+		return errors.NewBadRequest("using and with check expressions cannot be empty")
+	}
+
+	// Check using with insert command
+	if spec.Using != nil && spec.Command == v1alpha1.InsertPolicyCommand {
+		return errors.NewBadRequest("using expression cannot be used with INSERT command")
+	}
+
+	// Check with check with select and delete commands
+	if spec.WithCheck != nil && (spec.Command == v1alpha1.SelectPolicyCommand || spec.Command == v1alpha1.DeletePolicyCommand) {
+		return errors.NewBadRequest("with check expression cannot be used with SELECT or DELETE command")
+	}
+
+	// Check roles
+	if lo.Contains(spec.Roles, "") {
+		return errors.NewBadRequest("roles cannot have empty role listed")
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) updateInstance(
+	ctx context.Context,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+) (bool, error) {
+	// Deep copy
+	oCopy := instance.DeepCopy()
+
+	// Add finalizer
+	controllerutil.AddFinalizer(instance, config.Finalizer)
+
+	// Check if schema isn't set
+	if instance.Spec.Schema == "" {
+		// Set to default
+		instance.Spec.Schema = defaultPGPublicSchemaName
+	}
+
+	// Check if command isn't set
+	if instance.Spec.Command == "" {
+		// Set to default
+		instance.Spec.Command = v1alpha1.AllPolicyCommand
+	}
+
+	// Check if update is needed
+	if !reflect.DeepEqual(oCopy.ObjectMeta, instance.ObjectMeta) || !reflect.DeepEqual(oCopy.Spec, instance.Spec) {
+		return true, r.Update(ctx, instance)
+	}
+
+	return false, nil
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) manageDropPolicy(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+) error {
+	// Check if policy have been created
+	if instance.Status.Name == "" {
+		return nil
+	}
+
+	// Get pg db
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, instance.Spec.Database, instance.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// In case of not found => Can't delete => skip
+	if errors.IsNotFound(err) {
+		logger.Error(err, "can't delete policy because PostgresDatabase didn't exists anymore")
+
+		return nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	// In case of not found => Can't delete => skip
+	if errors.IsNotFound(err) {
+		logger.Error(err, "can't delete policy because PostgresEngineConfiguration didn't exists anymore")
+
+		return nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return err
+	}
+
+	// Create PG instance
+	pg := utils.CreatePgInstance(logger, secret.Data, pgEngCfg)
+
+	// Drop policy
+	// ? Note: Row level security is kept enabled on table as other policies can exist
+	err = pg.DropPolicy(ctx, pgDB.Status.Database, instance.Status.Schema, instance.Status.Table, instance.Status.Name)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.RowLevelSecurityPolicyFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlRowLevelSecurityPolicy,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = v1alpha1.RowLevelSecurityPolicyCreatedPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlRowLevelSecurityPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PostgresqlRowLevelSecurityPolicy{}).
+		Complete(r)
+}
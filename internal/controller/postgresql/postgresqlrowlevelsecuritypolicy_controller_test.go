@@ -0,0 +1,502 @@
+package postgresql
+
+import (
+	"errors"
+	gerrors "errors"
+	"fmt"
+
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimachineryErrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("PostgresqlRowLevelSecurityPolicy tests", func() {
+	AfterEach(cleanupFunction)
+
+	Describe("Spec error", func() {
+		It("shouldn't accept input without any specs", func() {
+			err := k8sClient.Create(ctx, &postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgrlspolicyName,
+					Namespace: pgrlspolicyNamespace,
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+
+			// Cast error
+			stErr, ok := err.(*apimachineryErrors.StatusError)
+
+			Expect(ok).To(BeTrue())
+
+			// Check that content is correct
+			causes := stErr.Status().Details.Causes
+
+			Expect(causes).To(HaveLen(3))
+
+			// Search all fields
+			fields := map[string]bool{
+				"spec.database": false,
+				"spec.name":     false,
+				"spec.table":    false,
+			}
+
+			// Loop over all causes
+			for _, cause := range causes {
+				fields[cause.Field] = true
+			}
+
+			// Check that all fields are found
+			for key, value := range fields {
+				if !value {
+					err := fmt.Errorf("%s found be found in error causes", key)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+
+		It("should fail when no expression is provided", func() {
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("using or with check expression must be set"))
+		})
+
+		It("should fail when an empty expression is provided", func() {
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny(""),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("using and with check expressions cannot be empty"))
+		})
+
+		It("should fail when using expression is provided with INSERT command", func() {
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Command: postgresqlv1alpha1.InsertPolicyCommand,
+				Using:   starAny("nb > 5"),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("using expression cannot be used with INSERT command"))
+		})
+
+		It("should fail when with check expression is provided with SELECT command", func() {
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Command:   postgresqlv1alpha1.SelectPolicyCommand,
+				WithCheck: starAny("nb > 5"),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("with check expression cannot be used with SELECT or DELETE command"))
+		})
+
+		It("should fail when an empty role is provided", func() {
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+				Roles: []string{""},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("roles cannot have empty role listed"))
+		})
+	})
+
+	Describe("Creation", func() {
+		It("should fail when table doesn't exist", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyFailedPhase))
+			Expect(item.Status.Message).To(Equal("table public.fake not found in database"))
+		})
+
+		It("should be ok with public role", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.Hash).NotTo(Equal(""))
+			Expect(item.Status.Name).To(Equal(pgrlspolicyPolicyName1))
+			Expect(item.Status.Schema).To(Equal(pgPublicSchemaName))
+			Expect(item.Status.Table).To(Equal("fake"))
+			Expect(item.Status.Using).To(Equal(starAny("(nb > 5)")))
+			Expect(item.Status.WithCheck).To(BeNil())
+			Expect(item.Spec.Schema).To(Equal(pgPublicSchemaName))
+			Expect(item.Spec.Command).To(Equal(postgresqlv1alpha1.AllPolicyCommand))
+
+			data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(&PolicyResult{
+				Using:      starAny("(nb > 5)"),
+				Command:    "ALL",
+				Roles:      []string{"public"},
+				Permissive: true,
+			}))
+
+			enabled, forced, err := getTableRowLevelSecurity(pgPublicSchemaName, "fake")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+			Expect(forced).To(BeFalse())
+		})
+
+		It("should be ok with database roles, restrictive and force row level security", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Command:     postgresqlv1alpha1.UpdatePolicyCommand,
+				Restrictive: true,
+				DatabaseRoles: []postgresqlv1alpha1.PolicyDatabaseRole{
+					postgresqlv1alpha1.ReaderPolicyDatabaseRole,
+					postgresqlv1alpha1.WriterPolicyDatabaseRole,
+				},
+				Using:                 starAny("nb > 5"),
+				WithCheck:             starAny("nb2 > 5"),
+				ForceRowLevelSecurity: true,
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.Using).To(Equal(starAny("(nb > 5)")))
+			Expect(item.Status.WithCheck).To(Equal(starAny("(nb2 > 5)")))
+
+			data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).NotTo(BeNil())
+			Expect(data.Command).To(Equal("UPDATE"))
+			Expect(data.Permissive).To(BeFalse())
+			Expect(data.Roles).To(ConsistOf(pgdb.Status.Roles.Reader, pgdb.Status.Roles.Writer))
+
+			enabled, forced, err := getTableRowLevelSecurity(pgPublicSchemaName, "fake")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+			Expect(forced).To(BeTrue())
+		})
+	})
+
+	Describe("Update", func() {
+		It("should be ok to change expressions and roles", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+
+			// Save
+			hash := item.Status.Hash
+			// Update
+			item.Spec.Using = starAny("nb > 10")
+			item.Spec.DatabaseRoles = []postgresqlv1alpha1.PolicyDatabaseRole{postgresqlv1alpha1.ReaderPolicyDatabaseRole}
+			// Update
+			err = k8sClient.Update(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedItem := waitPGRLSPolicyHashChange(item, hash)
+
+			// Checks
+			Expect(updatedItem.Status.Ready).To(BeTrue())
+			Expect(updatedItem.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+			Expect(updatedItem.Status.Using).To(Equal(starAny("(nb > 10)")))
+
+			data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(&PolicyResult{
+				Using:      starAny("(nb > 10)"),
+				Command:    "ALL",
+				Roles:      []string{pgdb.Status.Roles.Reader},
+				Permissive: true,
+			}))
+		})
+
+		It("should be ok to change command", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using:     starAny("nb > 5"),
+				WithCheck: starAny("nb > 5"),
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+
+			// Save
+			hash := item.Status.Hash
+			// Update
+			item.Spec.Command = postgresqlv1alpha1.SelectPolicyCommand
+			item.Spec.WithCheck = nil
+			// Update
+			err = k8sClient.Update(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedItem := waitPGRLSPolicyHashChange(item, hash)
+
+			// Checks
+			Expect(updatedItem.Status.Ready).To(BeTrue())
+			Expect(updatedItem.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+			Expect(updatedItem.Status.WithCheck).To(BeNil())
+
+			data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(&PolicyResult{
+				Using:      starAny("(nb > 5)"),
+				Command:    "SELECT",
+				Roles:      []string{"public"},
+				Permissive: true,
+			}))
+		})
+
+		It("should be ok to rename and move to another table", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("id = 'fake'"),
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+
+			// Save
+			hash := item.Status.Hash
+			// Rename
+			item.Spec.Name = pgrlspolicyPolicyName2
+			// Update
+			err = k8sClient.Update(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedItem := waitPGRLSPolicyHashChange(item, hash)
+
+			// Checks
+			Expect(updatedItem.Status.Ready).To(BeTrue())
+			Expect(updatedItem.Status.Name).To(Equal(pgrlspolicyPolicyName2))
+
+			oldData, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oldData).To(BeNil())
+
+			data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).NotTo(BeNil())
+
+			// Save
+			hash = updatedItem.Status.Hash
+			// Move to another table
+			updatedItem.Spec.Table = "fake2"
+			// Update
+			err = k8sClient.Update(ctx, updatedItem)
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedItem = waitPGRLSPolicyHashChange(updatedItem, hash)
+
+			// Checks
+			Expect(updatedItem.Status.Ready).To(BeTrue())
+			Expect(updatedItem.Status.Table).To(Equal("fake2"))
+
+			oldData, err = getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oldData).To(BeNil())
+
+			data, err = getPolicy(pgPublicSchemaName, "fake2", pgrlspolicyPolicyName2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).NotTo(BeNil())
+		})
+
+		It("should be ok to reconcile a policy changed outside of operator", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+
+			// Change policy outside of operator
+			Expect(rawSQLQuery(fmt.Sprintf(`ALTER POLICY "%s" ON public.fake USING (nb > 100)`, pgrlspolicyPolicyName1))).To(Succeed())
+			// Disable row level security outside of operator
+			Expect(rawSQLQuery(`ALTER TABLE public.fake DISABLE ROW LEVEL SECURITY`)).To(Succeed())
+
+			Eventually(
+				func() error {
+					data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+					if err != nil {
+						return err
+					}
+
+					if data == nil || data.Using == nil || *data.Using != "(nb > 5)" {
+						return errors.New("policy hasn't been reconciled by operator")
+					}
+
+					enabled, _, err := getTableRowLevelSecurity(pgPublicSchemaName, "fake")
+					if err != nil {
+						return err
+					}
+
+					if !enabled {
+						return errors.New("row level security hasn't been reconciled by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+		})
+	})
+
+	Describe("Deletion", func() {
+		It("should drop policy on delete", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create tables
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg policy
+			item := setupPGRLSPolicyWithPartialSpec(postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+				Using: starAny("nb > 5"),
+			})
+
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.RowLevelSecurityPolicyCreatedPhase))
+
+			// Delete object
+			err = k8sClient.Delete(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(
+				func() error {
+					data, err := getPolicy(pgPublicSchemaName, "fake", pgrlspolicyPolicyName1)
+					if err != nil {
+						return err
+					}
+
+					if data != nil {
+						return errors.New("hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Row level security is kept on table
+			enabled, _, err := getTableRowLevelSecurity(pgPublicSchemaName, "fake")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+		})
+	})
+})
+
+func waitPGRLSPolicyHashChange(
+	item *postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy,
+	hash string,
+) *postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy {
+	updatedItem := &postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy{}
+
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Name,
+				Namespace: item.Namespace,
+			}, updatedItem)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if updatedItem.Status.Hash == hash {
+				return gerrors.New("hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return updatedItem
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PostgresqlSequenceSyncReconciler reconciles a PostgresqlSequenceSync object.
+type PostgresqlSequenceSyncReconciler struct {
+	Recorder record.EventRecorder
+	client.Client
+	Scheme                              *runtime.Scheme
+	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	Log                                 logr.Logger
+	ControllerName                      string
+	ReconcileTimeout                    time.Duration
+}
+
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlsequencesyncs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlsequencesyncs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=postgresql.easymile.com,resources=postgresqlsequencesyncs/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+// Reconcile function to compare the state specified by
+// the PostgresqlSequenceSync object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.15.0/pkg/reconcile
+func (r *PostgresqlSequenceSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:wsl // it is like that
+	// Issue with this logger: controller and controllerKind are incorrect
+	// Build another logger from upper to fix this.
+	// reqLogger := log.FromContext(ctx)
+
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
+	reqLogger.Info("Reconciling PostgresqlSequenceSync")
+
+	// Fetch the PostgresqlSequenceSync instance
+	instance := &v1alpha1.PostgresqlSequenceSync{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	// Original patch
+	originalPatch := client.MergeFrom(instance.DeepCopy())
+
+	// Create timeout in ctx
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.ReconcileTimeout)
+	// Defer cancel
+	defer cancel()
+
+	// Init result
+	var res ctrl.Result
+
+	errC := make(chan error, 1)
+
+	// Create wrapping function
+	cb := func() {
+		a, err := r.mainReconcile(timeoutCtx, reqLogger, instance, originalPatch)
+		// Save result
+		res = a
+		// Send error
+		errC <- err
+	}
+
+	// Start wrapped function
+	go cb()
+
+	// Run or timeout
+	select {
+	case <-timeoutCtx.Done():
+		// ? Note: Here use primary context otherwise update to set error will be aborted
+		return r.manageError(ctx, reqLogger, instance, originalPatch, timeoutCtx.Err())
+	case err := <-errC:
+		return res, err
+	}
+}
+
+func (r *PostgresqlSequenceSyncReconciler) mainReconcile(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	instance *v1alpha1.PostgresqlSequenceSync,
+	originalPatch client.Patch,
+) (ctrl.Result, error) {
+	// Deletion case
+	// ? Note: Nothing to clean
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	// Check if synchronization is already done
+	// ? Note: A synchronization is a one shot action
+	if instance.Status.Phase == v1alpha1.SequenceSyncSucceededPhase {
+		return ctrl.Result{}, nil
+	}
+
+	// Validate
+	err := r.validate(instance)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Save links for easy use
+	pubLink := instance.Spec.Publication
+	// Try to get namespace from spec
+	pubNamespace := pubLink.Namespace
+	if pubNamespace == "" {
+		// Namespace not found, take it from instance namespace
+		pubNamespace = instance.Namespace
+	}
+
+	// Get publication
+	pgPub := &v1alpha1.PostgresqlPublication{}
+	err = r.Get(ctx, types.NamespacedName{Name: pubLink.Name, Namespace: pubNamespace}, pgPub)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Check that publication is ready before continue
+	if !pgPub.Status.Ready || pgPub.Status.Name == "" {
+		reqLogger.Info("PostgresqlPublication not ready, waiting for it")
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because PostgresqlPublication isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Find source linked resources
+	sourcePG, sourceDB, err := r.findDatabaseResources(ctx, reqLogger, pgPub.Spec.Database, pgPub.Namespace)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if resources aren't ready
+	if sourcePG == nil {
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because source PostgresqlDatabase or PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Find target linked resources
+	targetPG, targetDB, err := r.findDatabaseResources(ctx, reqLogger, instance.Spec.TargetDatabase, instance.Namespace)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+	// Check if resources aren't ready
+	if targetPG == nil {
+		r.Recorder.Event(instance, "Warning", "Processing", "Processing stopped because target PostgresqlDatabase or PostgresqlEngineConfiguration isn't ready. Waiting for it.")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Check that target isn't the source
+	if sourceDB.Name == targetDB.Name && sourceDB.Namespace == targetDB.Namespace {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, errors.NewBadRequest("target database cannot be the publication database"))
+	}
+
+	// Get source sequences
+	sequences, err := sourcePG.GetPublicationSequences(ctx, sourceDB.Status.Database, pgPub.Status.Name)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Compute target values
+	targetValues, results, skipped := buildSequenceSyncValues(sequences, instance.Spec.Offset)
+
+	// Set target sequences
+	err = targetPG.SetSequencesValue(ctx, targetDB.Status.Database, targetValues)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	r.Recorder.Eventf(instance, "Normal", "SequencesSynchronized", "%d sequences synchronized", len(results))
+
+	// Save status
+	instance.Status.Sequences = results
+	instance.Status.SkippedSequences = skipped
+	instance.Status.SyncTime = time.Now().UTC().Format(time.RFC3339)
+
+	return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+}
+
+// findDatabaseResources will return PG instance and database for a database link.
+// PG instance will be nil if database or engine configuration isn't ready.
+func (r *PostgresqlSequenceSyncReconciler) findDatabaseResources(
+	ctx context.Context,
+	reqLogger logr.Logger,
+	link *common.CRLink,
+	namespace string,
+) (postgres.PG, *v1alpha1.PostgresqlDatabase, error) {
+	// Try to find pg db CR
+	pgDB, err := utils.FindPgDatabaseFromLink(ctx, r.Client, link, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check that postgres database is ready before continue
+	if !pgDB.Status.Ready {
+		reqLogger.Info(fmt.Sprintf("PostgresqlDatabase %s/%s not ready, waiting for it", pgDB.Namespace, pgDB.Name))
+
+		return nil, pgDB, nil
+	}
+
+	// Try to find PostgresqlEngineConfiguration CR
+	pgEngCfg, err := utils.FindPgEngineCfg(ctx, r.Client, pgDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check that postgres engine configuration is ready before continue
+	if !pgEngCfg.Status.Ready {
+		reqLogger.Info(fmt.Sprintf("PostgresqlEngineConfiguration %s/%s not ready, waiting for it", pgEngCfg.Namespace, pgEngCfg.Name))
+
+		return nil, pgDB, nil
+	}
+
+	// Get secret linked to PostgresqlEngineConfiguration CR
+	secret, err := utils.FindSecretPgEngineCfg(ctx, r.Client, pgEngCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create PG instance
+	return utils.CreatePgInstance(reqLogger, secret.Data, pgEngCfg), pgDB, nil
+}
+
+// buildSequenceSyncValues will return values to set on target, status results and skipped sequences.
+// Sequences never used on source are skipped.
+func buildSequenceSyncValues(
+	sequences []*postgres.SequenceValue,
+	offset int64,
+) ([]*postgres.SequenceValue, []*v1alpha1.SequenceSyncResult, []string) {
+	targetValues := []*postgres.SequenceValue{}
+	results := []*v1alpha1.SequenceSyncResult{}
+	skipped := []string{}
+
+	// Loop over sequences
+	for _, it := range sequences {
+		name := fmt.Sprintf("%s.%s", it.SchemaName, it.SequenceName)
+
+		// Check if sequence have been used
+		if it.LastValue == nil {
+			skipped = append(skipped, name)
+
+			continue
+		}
+
+		// Compute value
+		value := *it.LastValue + offset
+
+		// Save
+		targetValues = append(targetValues, &postgres.SequenceValue{
+			SchemaName:   it.SchemaName,
+			SequenceName: it.SequenceName,
+			LastValue:    &value,
+		})
+		results = append(results, &v1alpha1.SequenceSyncResult{
+			Name:        name,
+			SourceValue: *it.LastValue,
+			TargetValue: value,
+		})
+	}
+
+	return targetValues, results, skipped
+}
+
+func (*PostgresqlSequenceSyncReconciler) validate(
+	instance *v1alpha1.PostgresqlSequenceSync,
+) error {
+	// Save spec for easy use
+	spec := instance.Spec
+
+	// Check publication
+	if spec.Publication == nil || spec.Publication.Name == "" {
+		return errors.NewBadRequest("publication must be set")
+	}
+
+	// Check target database
+	if spec.TargetDatabase == nil || spec.TargetDatabase.Name == "" {
+		return errors.NewBadRequest("target database must be set")
+	}
+
+	// Check offset
+	if spec.Offset < 0 {
+		return errors.NewBadRequest("offset cannot be negative")
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlSequenceSyncReconciler) manageError(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlSequenceSync,
+	originalPatch client.Patch,
+	issue error,
+) (reconcile.Result, error) {
+	logger.Error(issue, "issue raised in reconcile")
+	// Add kubernetes event
+	r.Recorder.Event(instance, "Warning", "ProcessingError", issue.Error())
+
+	// Update status
+	instance.Status.Message = issue.Error()
+	instance.Status.Ready = false
+	instance.Status.Phase = v1alpha1.SequenceSyncFailedPhase
+
+	// Increase fail counter
+	r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		logger.Error(err, "unable to update status")
+	}
+
+	// Return error
+	return ctrl.Result{}, issue
+}
+
+func (r *PostgresqlSequenceSyncReconciler) manageSuccess(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlSequenceSync,
+	originalPatch client.Patch,
+) (reconcile.Result, error) {
+	// Update status
+	instance.Status.Message = ""
+	instance.Status.Ready = true
+	instance.Status.Phase = v1alpha1.SequenceSyncSucceededPhase
+
+	// Patch status
+	err := r.Status().Patch(ctx, instance, originalPatch)
+	if err != nil {
+		// Increase fail counter
+		r.ControllerRuntimeDetailedErrorTotal.WithLabelValues(r.ControllerName, instance.Namespace, instance.Name).Inc()
+
+		logger.Error(err, "unable to update status")
+
+		// Return error
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Reconcile done")
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresqlSequenceSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.PostgresqlSequenceSync{}).
+		Complete(r)
+}
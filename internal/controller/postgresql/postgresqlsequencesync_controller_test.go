@@ -0,0 +1,93 @@
+package postgresql
+
+import (
+	"github.com/easymile/postgresql-operator/api/postgresql/common"
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PostgresqlSequenceSync tests", func() {
+	AfterEach(cleanupFunction)
+
+	Describe("Spec error", func() {
+		It("should fail when target database isn't set", func() {
+			item := setupPGSequenceSync(postgresqlv1alpha1.PostgresqlSequenceSyncSpec{
+				Publication:    &common.CRLink{Name: pgpublicationName},
+				TargetDatabase: &common.CRLink{},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.SequenceSyncFailedPhase))
+			Expect(item.Status.Message).To(Equal("target database must be set"))
+		})
+	})
+
+	Describe("Synchronization", func() {
+		It("should fail when target database is the publication database", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			err := create2KnownTablesWithColumnsInPublicSchema()
+			Expect(err).NotTo(HaveOccurred())
+
+			// Setup a pg publication
+			setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "fake"}},
+			})
+
+			item := setupPGSequenceSync(postgresqlv1alpha1.PostgresqlSequenceSyncSpec{
+				Publication:    &common.CRLink{Name: pgpublicationName},
+				TargetDatabase: &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.SequenceSyncFailedPhase))
+			Expect(item.Status.Message).To(Equal("target database cannot be the publication database"))
+		})
+
+		It("should synchronize sequences of published tables with an offset", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdbs
+			setupPGDB(false)
+			setupPGDB2()
+
+			// Create source table with used sequence and an unused one
+			Expect(rawSQLQuery(`CREATE TABLE public.seqtable (id serial, nb int)`)).To(Succeed())
+			Expect(rawSQLQuery(`CREATE TABLE public.seqtable2 (id serial, nb int)`)).To(Succeed())
+			Expect(rawSQLQuery(`INSERT INTO public.seqtable (nb) VALUES (1), (2), (3)`)).To(Succeed())
+			// Create target tables
+			Expect(rawSQLQueryInDBName(pgdbDBName2, `CREATE TABLE public.seqtable (id serial, nb int)`)).To(Succeed())
+			Expect(rawSQLQueryInDBName(pgdbDBName2, `CREATE TABLE public.seqtable2 (id serial, nb int)`)).To(Succeed())
+
+			// Setup a pg publication
+			setupPGPublicationWithPartialSpec(postgresqlv1alpha1.PostgresqlPublicationSpec{
+				Tables: []*postgresqlv1alpha1.PostgresqlPublicationTable{{TableName: "seqtable"}, {TableName: "seqtable2"}},
+			})
+
+			item := setupPGSequenceSync(postgresqlv1alpha1.PostgresqlSequenceSyncSpec{
+				Publication:    &common.CRLink{Name: pgpublicationName},
+				TargetDatabase: &common.CRLink{Name: pgdbName2, Namespace: pgdbNamespace},
+				Offset:         100,
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.SequenceSyncSucceededPhase))
+			Expect(item.Status.SyncTime).NotTo(BeEmpty())
+			Expect(item.Status.Sequences).To(Equal([]*postgresqlv1alpha1.SequenceSyncResult{
+				{Name: "public.seqtable_id_seq", SourceValue: 3, TargetValue: 103},
+			}))
+			Expect(item.Status.SkippedSequences).To(Equal([]string{"public.seqtable2_id_seq"}))
+
+			value, err := rawSQLQueryInt64InDBName(pgdbDBName2, `SELECT last_value FROM public.seqtable_id_seq`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(int64(103)))
+		})
+	})
+})
@@ -53,6 +53,7 @@ const (
 	UsernameSecretKey                          = "USERNAME"
 	PasswordSecretKey                          = "PASSWORD"
 	ManagedPasswordSize                        = 15
+	DefaultTableGrantSchema                    = "public"
 
 	SecretMainKeyPostgresURL     = "POSTGRES_URL"      //nolint:gosec // Nothing here
 	SecretMainKeyPostgresURLArgs = "POSTGRES_URL_ARGS" //nolint:gosec // Nothing here
@@ -72,6 +73,8 @@ type PostgresqlUserRoleReconciler struct {
 	client.Client
 	Scheme                              *runtime.Scheme
 	ControllerRuntimeDetailedErrorTotal *prometheus.CounterVec
+	OldRoleActiveSessions               *prometheus.GaugeVec
+	OldRoleActiveSessionsAge            *prometheus.GaugeVec
 	Log                                 logr.Logger
 	ControllerName                      string
 	ReconcileTimeout                    time.Duration
@@ -169,7 +172,7 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 		// Check status postgresrole and so if user have been created
 		if instance.Status.PostgresRole != "" {
 			// Consider the current user as another old one
-			instance.Status.OldPostgresRoles = append(instance.Status.OldPostgresRoles, instance.Status.PostgresRole)
+			addOldPostgresRole(instance, instance.Status.PostgresRole, time.Now())
 			// Unique them
 			instance.Status.OldPostgresRoles = funk.UniqString(instance.Status.OldPostgresRoles)
 		}
@@ -262,6 +265,13 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 	}
 
+	// Get password policy
+	passwordPolicy, err := r.getPasswordPolicy(instance, pgecCache)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
 	// Add finalizer
 	updated, err := r.updateInstance(ctx, instance)
 	// Check error
@@ -273,6 +283,29 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 		return reconcile.Result{}, nil
 	}
 
+	// Check if user role is suspended
+	if instance.Spec.Suspended {
+		// Manage suspension
+		err = r.manageSuspension(ctx, reqLogger, instance, pgecCache, pgecDBPrivilegeCache)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+
+		// Stop here, nothing else must be done while suspended
+		return r.manageSuccess(ctx, reqLogger, instance, originalPatch)
+	}
+
+	// Check if user role was suspended
+	if instance.Status.SuspendedTime != "" {
+		// Restore from suspension
+		err = r.restoreFromSuspension(ctx, reqLogger, instance, pgecCache)
+		// Check error
+		if err != nil {
+			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+		}
+	}
+
 	var usernameChanged, passwordChanged, rotateUserPasswordError bool
 
 	var workSec *corev1.Secret
@@ -281,7 +314,7 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 
 	// Check if it is a provided user
 	if instance.Spec.Mode == v1alpha1.ProvidedMode {
-		workSec, oldUsername, passwordChanged, err = r.createOrUpdateWorkSecretForProvidedMode(ctx, reqLogger, instance)
+		workSec, oldUsername, passwordChanged, err = r.createOrUpdateWorkSecretForProvidedMode(ctx, reqLogger, instance, passwordPolicy)
 		// Check error
 		if err != nil {
 			return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -291,6 +324,7 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 			ctx,
 			reqLogger,
 			instance,
+			passwordPolicy,
 		)
 		// Check error
 		if err != nil {
@@ -313,7 +347,7 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 	// Check if username have changed
 	if usernameChanged {
 		// Update status to add username for deletion
-		instance.Status.OldPostgresRoles = append(instance.Status.OldPostgresRoles, oldUsername)
+		addOldPostgresRole(instance, oldUsername, time.Now())
 	}
 
 	// Create PG instances
@@ -348,8 +382,21 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 	}
 
+	// Compute last password changed time
+	lastPasswordChangedTime := instance.Status.LastPasswordChangedTime
+	if passwordChanged || usernameChanged || lastPasswordChangedTime == "" {
+		lastPasswordChangedTime = time.Now().Format(time.RFC3339)
+	}
+
+	// Compute password expiration
+	validUntil, err := computePasswordValidUntil(instance, lastPasswordChangedTime)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
 	// Create or update user role if necessary
-	err = r.managePGUserRoles(ctx, reqLogger, instance, pgInstancesCache, pgecCache, username, password, passwordChanged)
+	err = r.managePGUserRoles(ctx, reqLogger, instance, pgInstancesCache, pgecCache, username, password, passwordChanged, validUntil)
 	// Check error
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -359,9 +406,12 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 	// Note: This is important to have a chance to have old username for deletion
 	instance.Status.PostgresRole = username
 	instance.Status.RolePrefix = instance.Spec.RolePrefix
-
-	if passwordChanged || usernameChanged || instance.Status.LastPasswordChangedTime == "" {
-		instance.Status.LastPasswordChangedTime = time.Now().Format(time.RFC3339)
+	instance.Status.LastPasswordChangedTime = lastPasswordChangedTime
+	// Save password expiration
+	if validUntil != nil {
+		instance.Status.PasswordExpirationTime = validUntil.Format(time.RFC3339)
+	} else {
+		instance.Status.PasswordExpirationTime = ""
 	}
 
 	//
@@ -369,7 +419,18 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 	//
 
 	// Manage rights
-	err = r.managePGUserRights(ctx, reqLogger, instance, pgInstancesCache, pgecDBPrivilegeCache, username)
+	// Split active and expired privileges
+	activePgecDBPrivilegeCache, expiredPgecDBPrivilegeCache := splitExpiredPrivileges(pgecDBPrivilegeCache, time.Now())
+
+	// Manage rights
+	err = r.managePGUserRights(ctx, reqLogger, instance, pgInstancesCache, activePgecDBPrivilegeCache, expiredPgecDBPrivilegeCache, username)
+	// Check error
+	if err != nil {
+		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
+	}
+
+	// Manage table grants
+	err = r.managePGUserTableGrants(ctx, reqLogger, instance, pgInstancesCache, activePgecDBPrivilegeCache, username)
 	// Check error
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -380,14 +441,14 @@ func (r *PostgresqlUserRoleReconciler) mainReconcile(
 	//
 
 	// Manage secrets
-	err = r.manageSecrets(ctx, reqLogger, instance, pgecCache, pgecDBPrivilegeCache, username, password)
+	err = r.manageSecrets(ctx, reqLogger, instance, pgecCache, activePgecDBPrivilegeCache, username, password)
 	// Check error
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
 	}
 
 	// Clean old secrets
-	err = r.cleanOldSecrets(ctx, reqLogger, instance, pgecDBPrivilegeCache)
+	err = r.cleanOldSecrets(ctx, reqLogger, instance, activePgecDBPrivilegeCache)
 	// Check error
 	if err != nil {
 		return r.manageError(ctx, reqLogger, instance, originalPatch, err)
@@ -554,24 +615,61 @@ func (r *PostgresqlUserRoleReconciler) newSecretForPGUser(
 	username, password string,
 	pgec *v1alpha1.PostgresqlEngineConfiguration,
 ) (*corev1.Secret, error) {
+	// Build data
+	data := buildPGUserSecretData(
+		pgec,
+		rolePrivilege.ConnectionType,
+		rolePrivilege.ExtraConnectionURLParameters,
+		dbInstance.Status.Database,
+		username, password,
+	)
+
+	labels := map[string]string{
+		"app": instance.Name,
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rolePrivilege.GeneratedSecretName,
+			Namespace: instance.Namespace,
+			Labels:    labels,
+		},
+		Data: data,
+	}
+
+	// Set owner references
+	err := controllerutil.SetControllerReference(instance, secret, r.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// buildPGUserSecretData will build generated secret data for a user on a database.
+func buildPGUserSecretData(
+	pgec *v1alpha1.PostgresqlEngineConfiguration,
+	connectionType v1alpha1.ConnectionTypesSpecEnum,
+	extraConnectionURLParameters map[string]string,
+	database, username, password string,
+) map[string][]byte {
 	// Prepare user connections with primary as default value
 	uc := pgec.Spec.UserConnections.PrimaryConnection
 	// Check if it is a bouncer connection
-	if rolePrivilege.ConnectionType == v1alpha1.BouncerConnectionType {
+	if connectionType == v1alpha1.BouncerConnectionType {
 		uc = pgec.Spec.UserConnections.BouncerConnection
 	}
 
 	// Compute uri args from main ones to user defined ones
 	uriArgList := []string{uc.URIArgs}
 	// Loop over user defined list
-	for k, v := range rolePrivilege.ExtraConnectionURLParameters {
+	for k, v := range extraConnectionURLParameters {
 		uriArgList = append(uriArgList, fmt.Sprintf("%s=%s", k, v))
 	}
 	// Join
 	uriArgs := strings.Join(uriArgList, "&")
 
-	pgUserURL := postgres.TemplatePostgresqlURL(uc.Host, username, password, dbInstance.Status.Database, uc.Port)
-	pgUserURLWArgs := postgres.TemplatePostgresqlURLWithArgs(uc.Host, username, password, uriArgs, dbInstance.Status.Database, uc.Port)
+	pgUserURL := postgres.TemplatePostgresqlURL(uc.Host, username, password, database, uc.Port)
+	pgUserURLWArgs := postgres.TemplatePostgresqlURLWithArgs(uc.Host, username, password, uriArgs, database, uc.Port)
 
 	// Create secret data
 	data := map[string][]byte{
@@ -579,7 +677,7 @@ func (r *PostgresqlUserRoleReconciler) newSecretForPGUser(
 		SecretMainKeyPostgresURLArgs: []byte(pgUserURLWArgs),
 		SecretMainKeyPassword:        []byte(password),
 		SecretMainKeyLogin:           []byte(username),
-		SecretMainKeyDatabase:        []byte(dbInstance.Status.Database),
+		SecretMainKeyDatabase:        []byte(database),
 		SecretMainKeyHost:            []byte(uc.Host),
 		SecretMainKeyPort:            []byte(strconv.Itoa(uc.Port)),
 		SecretMainKeyArgs:            []byte(uriArgs),
@@ -589,7 +687,7 @@ func (r *PostgresqlUserRoleReconciler) newSecretForPGUser(
 	// Prepare replica user connections
 	rucList := pgec.Spec.UserConnections.ReplicaConnections
 	// Check if it is a bouncer connection
-	if rolePrivilege.ConnectionType == v1alpha1.BouncerConnectionType {
+	if connectionType == v1alpha1.BouncerConnectionType {
 		rucList = pgec.Spec.UserConnections.ReplicaBouncerConnections
 	}
 	// Loop over list to inject in data replica data
@@ -597,14 +695,14 @@ func (r *PostgresqlUserRoleReconciler) newSecretForPGUser(
 		// Compute uri args from main ones to user defined ones
 		uriArgList := []string{ruc.URIArgs}
 		// Loop over user defined list
-		for k, v := range rolePrivilege.ExtraConnectionURLParameters {
+		for k, v := range extraConnectionURLParameters {
 			uriArgList = append(uriArgList, fmt.Sprintf("%s=%s", k, v))
 		}
 		// Join
 		uriArgs := strings.Join(uriArgList, "&")
 
-		replicaPGUserURL := postgres.TemplatePostgresqlURL(ruc.Host, username, password, dbInstance.Status.Database, ruc.Port)
-		replicaPGUserURLWArgs := postgres.TemplatePostgresqlURLWithArgs(ruc.Host, username, password, uriArgs, dbInstance.Status.Database, ruc.Port)
+		replicaPGUserURL := postgres.TemplatePostgresqlURL(ruc.Host, username, password, database, ruc.Port)
+		replicaPGUserURLWArgs := postgres.TemplatePostgresqlURLWithArgs(ruc.Host, username, password, uriArgs, database, ruc.Port)
 
 		// Build template
 		keyTemplate := SecretKeyReplicaPrefix + "_" + strconv.Itoa(i) + "_%s"
@@ -613,31 +711,13 @@ func (r *PostgresqlUserRoleReconciler) newSecretForPGUser(
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyPostgresURLArgs)] = []byte(replicaPGUserURLWArgs)
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyPassword)] = []byte(password)
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyLogin)] = []byte(username)
-		data[fmt.Sprintf(keyTemplate, SecretMainKeyDatabase)] = []byte(dbInstance.Status.Database)
+		data[fmt.Sprintf(keyTemplate, SecretMainKeyDatabase)] = []byte(database)
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyHost)] = []byte(ruc.Host)
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyPort)] = []byte(strconv.Itoa(ruc.Port))
 		data[fmt.Sprintf(keyTemplate, SecretMainKeyArgs)] = []byte(uriArgs)
 	}
 
-	labels := map[string]string{
-		"app": instance.Name,
-	}
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      rolePrivilege.GeneratedSecretName,
-			Namespace: instance.Namespace,
-			Labels:    labels,
-		},
-		Data: data,
-	}
-
-	// Set owner references
-	err := controllerutil.SetControllerReference(instance, secret, r.Scheme)
-	if err != nil {
-		return nil, err
-	}
-
-	return secret, nil
+	return data
 }
 
 func (r *PostgresqlUserRoleReconciler) managePGUserRights(
@@ -646,6 +726,7 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRights(
 	instance *v1alpha1.PostgresqlUserRole,
 	pgInstanceCache map[string]postgres.PG,
 	pgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
+	expiredPgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
 	username string,
 ) error {
 	// Loop on pg instances
@@ -670,6 +751,12 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRights(
 		// Loop over privilege cache list
 		for _, pcache := range dbPrivilegeCacheList {
 			groupRole := r.getDBRoleFromPrivilege(pcache.DBInstance, pcache.UserPrivilege)
+			// Check if there isn't any group role (custom privilege case)
+			// Note: In this case, set role setting is kept in list to be revoked
+			if groupRole == "" {
+				continue
+			}
+
 			// Check if item is in the list
 			contains := funk.ContainsString(memberOf, groupRole)
 			// Check if it doesn't contain
@@ -684,6 +771,17 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRights(
 
 				logger.Info("Successfully granted user in engine", "postgresqlEngine", key, "groupRole", groupRole)
 				r.Recorder.Eventf(instance, "Normal", "Updated", "Successfully granted user to %s in engine %s", groupRole, key)
+
+				// Check if it is a time-boxed privilege
+				if pcache.UserPrivilege.ExpiresAt != "" {
+					r.Recorder.Eventf(
+						instance,
+						"Normal",
+						"AccessGranted",
+						"Time-boxed access to %s granted in engine %s until %s",
+						groupRole, key, pcache.UserPrivilege.ExpiresAt,
+					)
+				}
 			} else {
 				// Remove from list to keep only the deletion ones
 				memberOf = funk.SubtractString(memberOf, []string{groupRole})
@@ -736,6 +834,21 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRights(
 
 			logger.Info("Successfully revoked role from user in engine", "postgresqlEngine", key, "role", role)
 			r.Recorder.Eventf(instance, "Normal", "Updated", "Successfully revoked role %s from user in engine %s", role, key)
+
+			// Check if it is coming from an expired privilege
+			expired := funk.Find(expiredPgecDBPrivilegeCache[key], func(pcache *dbPrivilegeCache) bool {
+				return r.getDBRoleFromPrivilege(pcache.DBInstance, pcache.UserPrivilege) == role
+			})
+			if expired != nil {
+				logger.Info("Time-boxed access expired and have been revoked", "postgresqlEngine", key, "role", role)
+				r.Recorder.Eventf(
+					instance,
+					"Normal",
+					"AccessRevoked",
+					"Time-boxed access to %s expired at %s and has been revoked in engine %s",
+					role, expired.(*dbPrivilegeCache).UserPrivilege.ExpiresAt, key, //nolint:forcetypeassert//We know
+				)
+			}
 		}
 
 		// Manage revoke set role
@@ -755,11 +868,326 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRights(
 	return nil
 }
 
+func (r *PostgresqlUserRoleReconciler) managePGUserTableGrants(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlUserRole,
+	pgInstanceCache map[string]postgres.PG,
+	pgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
+	username string,
+) error {
+	// Init new table grant databases list
+	tableGrantDatabases := make([]*v1alpha1.UserRoleTableGrantDatabase, 0)
+
+	// Loop on pg instances
+	for key, pgInstance := range pgInstanceCache {
+		// Save databases managed from spec
+		managedDatabases := make([]string, 0)
+
+		// Loop over privilege cache list
+		for _, pcache := range pgecDBPrivilegeCache[key] {
+			// Ignore privileges without table grants
+			// Note: Table grants not created by operator mustn't be touched
+			if pcache.UserPrivilege.Privilege != v1alpha1.CustomPrivilege || len(pcache.UserPrivilege.TableGrants) == 0 {
+				continue
+			}
+
+			// Save values
+			db := pcache.DBInstance.Status.Database
+			pgdbKey := utils.CreateNameKey(pcache.DBInstance.Name, pcache.DBInstance.Namespace, instance.Namespace)
+
+			// Manage grants in database
+			applied, err := r.managePGUserTableGrantsInDatabase(
+				ctx, logger, instance, pgInstance,
+				pcache.UserPrivilege, findAppliedTableGrants(instance, key, db), db, pgdbKey, username,
+			)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			managedDatabases = append(managedDatabases, db)
+
+			// Save database and applied grants
+			tableGrantDatabases = append(tableGrantDatabases, &v1alpha1.UserRoleTableGrantDatabase{Engine: key, Database: db, Grants: applied})
+		}
+
+		// Loop over databases where grants were applied before
+		// Note: This is done to revoke grants on databases or custom privileges removed from spec
+		for _, it := range instance.Status.TableGrantDatabases {
+			// Ignore other engines and databases already managed
+			if it.Engine != key || funk.ContainsString(managedDatabases, it.Database) {
+				continue
+			}
+
+			// Check if database still exists
+			exists, err := pgInstance.IsDatabaseExist(ctx, it.Database)
+			// Check error
+			if err != nil {
+				return err
+			}
+			// Ignore removed databases
+			if !exists {
+				continue
+			}
+
+			// Revoke all applied grants in database
+			_, err = r.managePGUserTableGrantsInDatabase(
+				ctx, logger, instance, pgInstance,
+				&v1alpha1.PostgresqlUserRolePrivilege{}, it.Grants, it.Database, it.Database, username,
+			)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Keep databases on engines that aren't available anymore
+	for _, it := range instance.Status.TableGrantDatabases {
+		if _, ok := pgInstanceCache[it.Engine]; !ok {
+			tableGrantDatabases = append(tableGrantDatabases, it)
+		}
+	}
+
+	// Save new list
+	instance.Status.TableGrantDatabases = tableGrantDatabases
+
+	// Default
+	return nil
+}
+
+// findAppliedTableGrants will return grants applied by operator in a database from status.
+func findAppliedTableGrants(instance *v1alpha1.PostgresqlUserRole, engine, db string) []*v1alpha1.UserRoleAppliedTableGrant {
+	for _, it := range instance.Status.TableGrantDatabases {
+		if it.Engine == engine && it.Database == db {
+			return it.Grants
+		}
+	}
+
+	return nil
+}
+
+// managePGUserTableGrantsInDatabase will apply wanted grants and revoke grants previously applied by operator that aren't wanted anymore.
+// Grants applied by operator are returned.
+func (r *PostgresqlUserRoleReconciler) managePGUserTableGrantsInDatabase(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlUserRole,
+	pgInstance postgres.PG,
+	privilege *v1alpha1.PostgresqlUserRolePrivilege,
+	appliedGrants []*v1alpha1.UserRoleAppliedTableGrant,
+	db, pgdbKey, username string,
+) ([]*v1alpha1.UserRoleAppliedTableGrant, error) {
+	// Build wanted grants
+	wantedTableGrants, wantedColumnGrants, schemas := buildWantedTableGrants(privilege)
+
+	// Split previously applied grants
+	previousTableGrants, previousColumnGrants := splitAppliedTableGrants(appliedGrants)
+
+	// Ensure usage on schemas
+	for _, schema := range schemas {
+		err := pgInstance.GrantUsageOnSchema(ctx, db, schema, username)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get current table grants
+	currentTableGrants, err := pgInstance.GetRoleTableGrants(ctx, db, username)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke table grants applied by operator that aren't wanted anymore
+	for _, g := range previousTableGrants {
+		if containsTableGrant(wantedTableGrants, g) || !containsTableGrant(currentTableGrants, g) {
+			continue
+		}
+
+		err = pgInstance.RevokeOnTable(ctx, db, g.Schema, g.Table, g.Privilege, username)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("Successfully revoked table grant", "postgresqlDatabase", pgdbKey, "schema", g.Schema, "table", g.Table, "privilege", g.Privilege)
+		r.Recorder.Eventf(instance, "Normal", "Updated", "Successfully revoked %s on table %s.%s in database %s", g.Privilege, g.Schema, g.Table, pgdbKey)
+	}
+
+	// Grant missing table grants
+	for _, g := range wantedTableGrants {
+		if containsTableGrant(currentTableGrants, g) {
+			continue
+		}
+
+		err = pgInstance.GrantOnTable(ctx, db, g.Schema, g.Table, g.Privilege, username)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("Successfully granted table grant", "postgresqlDatabase", pgdbKey, "schema", g.Schema, "table", g.Table, "privilege", g.Privilege)
+		r.Recorder.Eventf(instance, "Normal", "Updated", "Successfully granted %s on table %s.%s in database %s", g.Privilege, g.Schema, g.Table, pgdbKey)
+	}
+
+	// Get current column grants
+	// Note: This must be done after table grants because revoking a table grant also revokes column ones
+	currentColumnGrants, err := pgInstance.GetRoleColumnGrants(ctx, db, username)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove column grants coming from table grants
+	currentColumnGrants = funk.Filter(currentColumnGrants, func(g *postgres.ColumnGrant) bool {
+		return !containsTableGrant(wantedTableGrants, &postgres.TableGrant{Schema: g.Schema, Table: g.Table, Privilege: g.Privilege})
+	}).([]*postgres.ColumnGrant) //nolint:forcetypeassert//We know
+
+	// Revoke column grants applied by operator that aren't wanted anymore
+	for _, g := range previousColumnGrants {
+		if containsColumnGrant(wantedColumnGrants, g) || !containsColumnGrant(currentColumnGrants, g) {
+			continue
+		}
+
+		err = pgInstance.RevokeOnColumns(ctx, db, g.Schema, g.Table, g.Privilege, []string{g.Column}, username)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info(
+			"Successfully revoked column grant",
+			"postgresqlDatabase", pgdbKey, "schema", g.Schema, "table", g.Table, "column", g.Column, "privilege", g.Privilege,
+		)
+		r.Recorder.Eventf(
+			instance, "Normal", "Updated",
+			"Successfully revoked %s on column %s of table %s.%s in database %s", g.Privilege, g.Column, g.Schema, g.Table, pgdbKey,
+		)
+	}
+
+	// Grant missing column grants
+	for _, g := range wantedColumnGrants {
+		if containsColumnGrant(currentColumnGrants, g) {
+			continue
+		}
+
+		err = pgInstance.GrantOnColumns(ctx, db, g.Schema, g.Table, g.Privilege, []string{g.Column}, username)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info(
+			"Successfully granted column grant",
+			"postgresqlDatabase", pgdbKey, "schema", g.Schema, "table", g.Table, "column", g.Column, "privilege", g.Privilege,
+		)
+		r.Recorder.Eventf(
+			instance, "Normal", "Updated",
+			"Successfully granted %s on column %s of table %s.%s in database %s", g.Privilege, g.Column, g.Schema, g.Table, pgdbKey,
+		)
+	}
+
+	// Build applied grants
+	applied := make([]*v1alpha1.UserRoleAppliedTableGrant, 0, len(wantedTableGrants)+len(wantedColumnGrants))
+	for _, g := range wantedTableGrants {
+		applied = append(applied, &v1alpha1.UserRoleAppliedTableGrant{Schema: g.Schema, Table: g.Table, Privilege: g.Privilege})
+	}
+
+	for _, g := range wantedColumnGrants {
+		applied = append(applied, &v1alpha1.UserRoleAppliedTableGrant{Schema: g.Schema, Table: g.Table, Column: g.Column, Privilege: g.Privilege})
+	}
+
+	return applied, nil
+}
+
+func splitAppliedTableGrants(
+	list []*v1alpha1.UserRoleAppliedTableGrant,
+) (tableGrants []*postgres.TableGrant, columnGrants []*postgres.ColumnGrant) {
+	for _, it := range list {
+		// Check if it is a table level grant
+		if it.Column == "" {
+			tableGrants = append(tableGrants, &postgres.TableGrant{Schema: it.Schema, Table: it.Table, Privilege: it.Privilege})
+
+			continue
+		}
+
+		columnGrants = append(columnGrants, &postgres.ColumnGrant{Schema: it.Schema, Table: it.Table, Column: it.Column, Privilege: it.Privilege})
+	}
+
+	return tableGrants, columnGrants
+}
+
+func buildWantedTableGrants(
+	privilege *v1alpha1.PostgresqlUserRolePrivilege,
+) (tableGrants []*postgres.TableGrant, columnGrants []*postgres.ColumnGrant, schemas []string) {
+	// Init
+	tableGrants = make([]*postgres.TableGrant, 0)
+	columnGrants = make([]*postgres.ColumnGrant, 0)
+	schemas = make([]string, 0)
+
+	// Loop over table grants
+	for _, tg := range privilege.TableGrants {
+		// Default schema
+		schema := tg.Schema
+		if schema == "" {
+			schema = DefaultTableGrantSchema
+		}
+
+		// Save schema
+		if !funk.ContainsString(schemas, schema) {
+			schemas = append(schemas, schema)
+		}
+
+		// Loop over privileges
+		for _, p := range tg.Privileges {
+			// Check if it is a table level grant
+			if len(tg.Columns) == 0 {
+				tableGrants = append(tableGrants, &postgres.TableGrant{Schema: schema, Table: tg.Table, Privilege: string(p)})
+
+				continue
+			}
+
+			// Column level grants
+			for _, col := range tg.Columns {
+				columnGrants = append(columnGrants, &postgres.ColumnGrant{Schema: schema, Table: tg.Table, Column: col, Privilege: string(p)})
+			}
+		}
+	}
+
+	return tableGrants, columnGrants, schemas
+}
+
+func containsTableGrant(list []*postgres.TableGrant, g *postgres.TableGrant) bool {
+	for _, it := range list {
+		if *it == *g {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsColumnGrant(list []*postgres.ColumnGrant, g *postgres.ColumnGrant) bool {
+	for _, it := range list {
+		if *it == *g {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (*PostgresqlUserRoleReconciler) getDBRoleFromPrivilege(
 	dbInstance *v1alpha1.PostgresqlDatabase,
 	userRolePrivilege *v1alpha1.PostgresqlUserRolePrivilege,
 ) string {
 	switch userRolePrivilege.Privilege {
+	case v1alpha1.CustomPrivilege:
+		// No group role in this case
+		return ""
 	case v1alpha1.ReaderPrivilege:
 		return dbInstance.Status.Roles.Reader
 	case v1alpha1.WriterPrivilege:
@@ -779,6 +1207,10 @@ func convertPostgresqlUserRoleAttributesToRoleAttributes(item *v1alpha1.Postgres
 		ConnectionLimit: item.ConnectionLimit,
 		Replication:     item.Replication,
 		BypassRLS:       item.BypassRLS,
+		CreateDB:        item.CreateDB,
+		CreateRole:      item.CreateRole,
+		Inherit:         item.Inherit,
+		Login:           item.Login,
 	}
 }
 
@@ -807,9 +1239,39 @@ func diffAttributes(sqlAttributes, wantedAttributes *postgres.RoleAttributes) *p
 			attributes.BypassRLS = &postgres.DefaultAttributeBypassRLS
 		}
 
-		// Stop here
-		return attributes
-	}
+		// Check CreateDB
+		if sqlAttributes.CreateDB != nil && *sqlAttributes.CreateDB != postgres.DefaultAttributeCreateDB {
+			// Change value needed => Reset to default
+			attributes.CreateDB = &postgres.DefaultAttributeCreateDB
+		}
+
+		// Check CreateRole
+		if sqlAttributes.CreateRole != nil && *sqlAttributes.CreateRole != postgres.DefaultAttributeCreateRole {
+			// Change value needed => Reset to default
+			attributes.CreateRole = &postgres.DefaultAttributeCreateRole
+		}
+
+		// Check Inherit
+		if sqlAttributes.Inherit != nil && *sqlAttributes.Inherit != postgres.DefaultAttributeInherit {
+			// Change value needed => Reset to default
+			attributes.Inherit = &postgres.DefaultAttributeInherit
+		}
+
+		// Check Login
+		if sqlAttributes.Login != nil && *sqlAttributes.Login != postgres.DefaultAttributeLogin {
+			// Change value needed => Reset to default
+			attributes.Login = &postgres.DefaultAttributeLogin
+		}
+
+		// Check ValidUntil
+		if sqlAttributes.ValidUntil != nil {
+			// Change value needed => Reset to default
+			attributes.ValidUntil = &postgres.DefaultAttributeValidUntil
+		}
+
+		// Stop here
+		return attributes
+	}
 
 	//
 	// Now we are in the case of an update is needed
@@ -851,9 +1313,179 @@ func diffAttributes(sqlAttributes, wantedAttributes *postgres.RoleAttributes) *p
 		}
 	}
 
+	// Check differences for CreateDB
+	if !reflect.DeepEqual(sqlAttributes.CreateDB, wantedAttributes.CreateDB) {
+		// Check if we are in a reset case
+		if wantedAttributes.CreateDB == nil && sqlAttributes.CreateDB != nil && *sqlAttributes.CreateDB != postgres.DefaultAttributeCreateDB {
+			// Change value needed => Reset to default
+			attributes.CreateDB = &postgres.DefaultAttributeCreateDB
+		} else {
+			// New value asked
+			attributes.CreateDB = wantedAttributes.CreateDB
+		}
+	}
+
+	// Check differences for CreateRole
+	if !reflect.DeepEqual(sqlAttributes.CreateRole, wantedAttributes.CreateRole) {
+		// Check if we are in a reset case
+		if wantedAttributes.CreateRole == nil && sqlAttributes.CreateRole != nil && *sqlAttributes.CreateRole != postgres.DefaultAttributeCreateRole {
+			// Change value needed => Reset to default
+			attributes.CreateRole = &postgres.DefaultAttributeCreateRole
+		} else {
+			// New value asked
+			attributes.CreateRole = wantedAttributes.CreateRole
+		}
+	}
+
+	// Check differences for Inherit
+	if !reflect.DeepEqual(sqlAttributes.Inherit, wantedAttributes.Inherit) {
+		// Check if we are in a reset case
+		if wantedAttributes.Inherit == nil && sqlAttributes.Inherit != nil && *sqlAttributes.Inherit != postgres.DefaultAttributeInherit {
+			// Change value needed => Reset to default
+			attributes.Inherit = &postgres.DefaultAttributeInherit
+		} else {
+			// New value asked
+			attributes.Inherit = wantedAttributes.Inherit
+		}
+	}
+
+	// Check differences for Login
+	if !reflect.DeepEqual(sqlAttributes.Login, wantedAttributes.Login) {
+		// Check if we are in a reset case
+		if wantedAttributes.Login == nil && sqlAttributes.Login != nil && *sqlAttributes.Login != postgres.DefaultAttributeLogin {
+			// Change value needed => Reset to default
+			attributes.Login = &postgres.DefaultAttributeLogin
+		} else {
+			// New value asked
+			attributes.Login = wantedAttributes.Login
+		}
+	}
+
+	// Check differences for ValidUntil
+	// Note: Times cannot be compared with DeepEqual because of locations
+	if sqlAttributes.ValidUntil != nil || wantedAttributes.ValidUntil != nil {
+		// Check if we are in a reset case
+		if wantedAttributes.ValidUntil == nil {
+			// Change value needed => Reset to default
+			attributes.ValidUntil = &postgres.DefaultAttributeValidUntil
+		} else if sqlAttributes.ValidUntil == nil || !sqlAttributes.ValidUntil.Equal(*wantedAttributes.ValidUntil) {
+			// New value asked
+			attributes.ValidUntil = wantedAttributes.ValidUntil
+		}
+	}
+
 	return attributes
 }
 
+func isPrivilegeExpired(privilege *v1alpha1.PostgresqlUserRolePrivilege, now time.Time) bool {
+	// Check if expiration is set
+	if privilege.ExpiresAt == "" {
+		return false
+	}
+
+	// Parse
+	// Note: This have been validated before
+	expiresAt, err := time.Parse(time.RFC3339, privilege.ExpiresAt)
+	// Check error
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(expiresAt)
+}
+
+func splitExpiredPrivileges(
+	pgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
+	now time.Time,
+) (active map[string][]*dbPrivilegeCache, expired map[string][]*dbPrivilegeCache) {
+	// Prepare results
+	active = make(map[string][]*dbPrivilegeCache)
+	expired = make(map[string][]*dbPrivilegeCache)
+
+	// Loop
+	for key, list := range pgecDBPrivilegeCache {
+		// Ensure key is present in active map even if all privileges are expired
+		active[key] = make([]*dbPrivilegeCache, 0)
+
+		for _, pcache := range list {
+			// Check if privilege is expired
+			if isPrivilegeExpired(pcache.UserPrivilege, now) {
+				expired[key] = append(expired[key], pcache)
+			} else {
+				active[key] = append(active[key], pcache)
+			}
+		}
+	}
+
+	return active, expired
+}
+
+func getNextPrivilegeExpiration(instance *v1alpha1.PostgresqlUserRole, now time.Time) time.Duration {
+	var res time.Duration
+
+	// Loop over privileges
+	for _, privi := range instance.Spec.Privileges {
+		// Check if expiration is set
+		if privi.ExpiresAt == "" {
+			continue
+		}
+
+		// Parse
+		expiresAt, err := time.Parse(time.RFC3339, privi.ExpiresAt)
+		// Check error
+		if err != nil {
+			continue
+		}
+
+		// Compute duration
+		d := expiresAt.Sub(now)
+		// Ignore already expired ones
+		if d <= 0 {
+			continue
+		}
+
+		// Keep the closest one
+		if res == 0 || d < res {
+			res = d
+		}
+	}
+
+	return res
+}
+
+func computePasswordValidUntil(instance *v1alpha1.PostgresqlUserRole, lastPasswordChangedTime string) (*time.Time, error) {
+	// Check if valid until is enabled
+	if instance.Spec.RoleAttributes == nil || instance.Spec.RoleAttributes.ValidUntilMargin == "" {
+		return nil, nil
+	}
+
+	// Parse durations
+	// Note: Those have been validated before
+	rotation, err := time.ParseDuration(instance.Spec.UserPasswordRotationDuration)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	margin, err := time.ParseDuration(instance.Spec.RoleAttributes.ValidUntilMargin)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse last change
+	lastChange, err := time.Parse(time.RFC3339, lastPasswordChangedTime)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute
+	res := lastChange.Add(rotation).Add(margin).UTC()
+
+	return &res, nil
+}
+
 func (r *PostgresqlUserRoleReconciler) managePGUserRoles(
 	ctx context.Context,
 	logger logr.Logger,
@@ -862,9 +1494,14 @@ func (r *PostgresqlUserRoleReconciler) managePGUserRoles(
 	pgecCache map[string]*v1alpha1.PostgresqlEngineConfiguration,
 	username, password string,
 	passwordChanged bool,
+	validUntil *time.Time,
 ) error {
 	// Build wantedAttributes
 	wantedAttributes := convertPostgresqlUserRoleAttributesToRoleAttributes(instance.Spec.RoleAttributes)
+	// Add password expiration if needed
+	if wantedAttributes != nil && validUntil != nil {
+		wantedAttributes.ValidUntil = validUntil
+	}
 
 	// Loop over all pg instances
 	for key, pgInstance := range pgInstanceCache {
@@ -943,12 +1580,23 @@ func (r *PostgresqlUserRoleReconciler) createOrUpdateWorkSecretForManagedMode( /
 	ctx context.Context,
 	logger logr.Logger,
 	instance *v1alpha1.PostgresqlUserRole,
+	passwordPolicy *v1alpha1.PasswordPolicy,
 ) (*corev1.Secret, string, bool, bool, error) {
 	// Prepare values
 	oldUsername := ""
 	passwordChanged := false
 	username := instance.Spec.RolePrefix + Login0Suffix
 	password := utils.GetRandomString(ManagedPasswordSize)
+	// Check if a password policy must be applied
+	if passwordPolicy != nil {
+		var err error
+		// Generate password with policy
+		password, err = utils.GeneratePasswordWithPolicy(passwordPolicy)
+		// Check error
+		if err != nil {
+			return nil, "", false, false, errors.NewBadRequest(err.Error())
+		}
+	}
 
 	// Create or update work secret with imported secret values
 	// Get current work secret
@@ -998,7 +1646,8 @@ func (r *PostgresqlUserRoleReconciler) createOrUpdateWorkSecretForManagedMode( /
 		r.Recorder.Event(instance, "Normal", "Updated", "Work secret created")
 	} else if (instance.Status.RolePrefix != "" && instance.Spec.RolePrefix != instance.Status.RolePrefix) ||
 		string(workSec.Data[UsernameSecretKey]) == "" ||
-		string(workSec.Data[PasswordSecretKey]) == "" { // Check if role have been changed or if work secret have been edited
+		string(workSec.Data[PasswordSecretKey]) == "" ||
+		(passwordPolicy != nil && utils.ValidatePasswordWithPolicy(string(workSec.Data[PasswordSecretKey]), passwordPolicy) != nil) { // Check if role have been changed, if work secret have been edited or if password isn't compliant with policy
 		// Need to perform changes
 		// Update flags
 		passwordChanged = true
@@ -1025,8 +1674,8 @@ func (r *PostgresqlUserRoleReconciler) createOrUpdateWorkSecretForManagedMode( /
 			return nil, "", false, false, err
 		}
 
-		logger.Info("Successfully updated work secret with new user/password tuple because role name have changed or work secret have been edited")
-		r.Recorder.Event(instance, "Normal", "Updated", "Work secret updated with new user/password tuple because role name have changed or work secret have been edited")
+		logger.Info("Successfully updated work secret with new user/password tuple because role name have changed, work secret have been edited or password policy have changed")
+		r.Recorder.Event(instance, "Normal", "Updated", "Work secret updated with new user/password tuple because role name have changed, work secret have been edited or password policy have changed")
 		r.Recorder.Event(workSec, "Normal", "Updated", "Secret updated by PostgresqlUserRole controller")
 	} else if instance.Spec.UserPasswordRotationDuration != "" && instance.Status.LastPasswordChangedTime != "" { // Check if rolling password is enabled and a previous run have been performed
 		// Get duration
@@ -1095,6 +1744,7 @@ func (r *PostgresqlUserRoleReconciler) createOrUpdateWorkSecretForProvidedMode(
 	ctx context.Context,
 	logger logr.Logger,
 	instance *v1alpha1.PostgresqlUserRole,
+	passwordPolicy *v1alpha1.PasswordPolicy,
 ) (*corev1.Secret, string, bool, error) {
 	// Get import secret
 	importSecret, err := utils.GetSecret(ctx, r.Client, instance.Spec.ImportSecretName, instance.Namespace)
@@ -1106,6 +1756,16 @@ func (r *PostgresqlUserRoleReconciler) createOrUpdateWorkSecretForProvidedMode(
 	// Save data
 	username := string(importSecret.Data[UsernameSecretKey])
 	password := string(importSecret.Data[PasswordSecretKey])
+
+	// Check if password must be compliant with a policy
+	if passwordPolicy != nil {
+		// Validate password
+		err = utils.ValidatePasswordWithPolicy(password, passwordPolicy)
+		// Check error
+		if err != nil {
+			return nil, "", false, errors.NewBadRequest("Import secret password isn't compliant with password policy: " + err.Error())
+		}
+	}
 	oldUsername := ""
 	passwordChanged := false
 
@@ -1214,6 +1874,25 @@ func (r *PostgresqlUserRoleReconciler) manageActiveSessionsAndDropOldRoles(
 ) error {
 	// Build new list of old roles
 	newOldRoleList := make([]string, 0)
+	// Build new list of old roles sessions
+	newOldRoleSessionsList := make([]*v1alpha1.OldPostgresRoleSessions, 0)
+
+	// Prepare grace period
+	var gracePeriod time.Duration
+	// Check if grace period is set
+	if instance.Spec.OldRolesTerminationGracePeriod != "" {
+		// Parse it
+		dur, err := time.ParseDuration(instance.Spec.OldRolesTerminationGracePeriod)
+		// Check error
+		if err != nil {
+			return err
+		}
+		// Save
+		gracePeriod = dur
+	}
+
+	// Save now
+	now := time.Now()
 
 	// Loop over old users
 	for _, oldUsername := range instance.Status.OldPostgresRoles {
@@ -1226,56 +1905,484 @@ func (r *PostgresqlUserRoleReconciler) manageActiveSessionsAndDropOldRoles(
 				return err
 			}
 
-			// Check if it exists
-			if exists {
-				// Check if there is a currently active session with this user
-				sessionDetected, err := pgInstance.DoesRoleHaveActiveSession(ctx, oldUsername)
+			// Check if it doesn't exist
+			if !exists {
+				continue
+			}
+
+			// Get currently active sessions with this user
+			sessions, err := pgInstance.GetRoleActiveSessions(ctx, oldUsername)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if there are active sessions
+			if sessions.Count != 0 {
+				// Build sessions status
+				sessionsStatus := &v1alpha1.OldPostgresRoleSessions{
+					Role:           oldUsername,
+					Engine:         key,
+					DetectedTime:   now.Format(time.RFC3339),
+					ActiveSessions: sessions.Count,
+				}
+				// Check if oldest session start is available
+				if sessions.OldestSessionStart != nil {
+					sessionsStatus.OldestSessionStartTime = sessions.OldestSessionStart.Format(time.RFC3339)
+				}
+
+				// Search for a previous detection
+				for _, it := range instance.Status.OldPostgresRolesSessions {
+					if it.Role == oldUsername && it.Engine == key {
+						// Keep first detection time
+						sessionsStatus.DetectedTime = it.DetectedTime
+					}
+				}
+
+				// Parse detection time
+				detectedTime, err := time.Parse(time.RFC3339, sessionsStatus.DetectedTime)
 				// Check error
 				if err != nil {
 					return err
 				}
 
-				// Check session isn't active
-				if !sessionDetected {
-					// Get all databases linked to this engine
-					dbPrivilegeCacheList := pgecDBPrivilegeCache[key]
-					// Loop over databases
-					for _, item := range dbPrivilegeCacheList {
-						// Some PG instance are limited and this can be done in generic
-						// This limitation needs to add the main user as member of the current role
-						err = pgInstance.GrantRole(ctx, oldUsername, pgInstance.GetUser(), pgecCache[key].Spec.AllowGrantAdminOption)
-						// Check error
-						if err != nil {
-							return err
-						}
-						// Change and drop owner by
-						err = pgInstance.ChangeAndDropOwnedBy(ctx, oldUsername, item.DBInstance.Status.Roles.Owner, item.DBInstance.Status.Database)
-						// Check error
-						if err != nil {
-							return err
-						}
-					}
-					// Drop it
-					err = pgInstance.DropRole(ctx, oldUsername)
-					// Check error
-					if err != nil {
-						return err
-					}
+				// Compute grace period start
+				// ? Note: Grace period starts at rotation, detection time is used for roles rotated before this was saved
+				graceStart := detectedTime
+				if rotatedTime := getOldPostgresRoleRotatedTime(instance, oldUsername); rotatedTime != nil {
+					graceStart = *rotatedTime
+				}
 
-					logger.Info("Role successfully deleted", "engine", key, "role", oldUsername)
-					r.Recorder.Eventf(instance, "Normal", "Processing", "Role %s successfully deleted on engine %s", oldUsername, key)
-				} else {
+				// Check if grace period isn't enabled or not expired
+				if gracePeriod == 0 || now.Sub(graceStart) < gracePeriod {
 					// Active session, save account as must be deleted after
 					newOldRoleList = append(newOldRoleList, oldUsername)
-					logger.Info("Role still active sessions, ignoring deletion", "engine", key, "role", oldUsername)
-					r.Recorder.Eventf(instance, "Warning", "Warning", "Role %s still have active session on engine %s, ignoring deletion", oldUsername, key)
+					newOldRoleSessionsList = append(newOldRoleSessionsList, sessionsStatus)
+
+					// Update metrics
+					r.OldRoleActiveSessions.WithLabelValues(instance.Namespace, instance.Name, oldUsername, key).Set(float64(sessions.Count))
+					r.OldRoleActiveSessionsAge.WithLabelValues(instance.Namespace, instance.Name, oldUsername, key).Set(now.Sub(detectedTime).Seconds())
+
+					logger.Info("Role still active sessions, ignoring deletion", "engine", key, "role", oldUsername, "activeSessions", sessions.Count)
+					r.Recorder.Eventf(instance, "Warning", "Warning", "Role %s still have %d active session(s) on engine %s, ignoring deletion", oldUsername, sessions.Count, key)
+
+					continue
+				}
+
+				// Grace period expired
+				// Some PG instance are limited and this can be done in generic
+				// This limitation needs to add the main user as member of the current role to be able to terminate sessions
+				err = pgInstance.GrantRole(ctx, oldUsername, pgInstance.GetUser(), pgecCache[key].Spec.AllowGrantAdminOption)
+				// Check error
+				if err != nil {
+					return err
+				}
+				// Terminate sessions
+				err = pgInstance.TerminateRoleSessions(ctx, oldUsername)
+				// Check error
+				if err != nil {
+					return err
 				}
+
+				logger.Info("Role active sessions terminated because grace period expired", "engine", key, "role", oldUsername, "activeSessions", sessions.Count)
+				r.Recorder.Eventf(
+					instance,
+					"Warning",
+					"Terminated",
+					"Role %s had still %d active session(s) on engine %s after grace period, sessions terminated",
+					oldUsername, sessions.Count, key,
+				)
 			}
+
+			// Get all databases linked to this engine
+			dbPrivilegeCacheList := pgecDBPrivilegeCache[key]
+			// Loop over databases
+			for _, item := range dbPrivilegeCacheList {
+				// Some PG instance are limited and this can be done in generic
+				// This limitation needs to add the main user as member of the current role
+				err = pgInstance.GrantRole(ctx, oldUsername, pgInstance.GetUser(), pgecCache[key].Spec.AllowGrantAdminOption)
+				// Check error
+				if err != nil {
+					return err
+				}
+				// Change and drop owner by
+				err = pgInstance.ChangeAndDropOwnedBy(ctx, oldUsername, item.DBInstance.Status.Roles.Owner, item.DBInstance.Status.Database)
+				// Check error
+				if err != nil {
+					return err
+				}
+			}
+			// Drop it
+			err = pgInstance.DropRole(ctx, oldUsername)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			logger.Info("Role successfully deleted", "engine", key, "role", oldUsername)
+			r.Recorder.Eventf(instance, "Normal", "Processing", "Role %s successfully deleted on engine %s", oldUsername, key)
+		}
+	}
+
+	// Clean metrics for old roles sessions that aren't present anymore
+	for _, it := range instance.Status.OldPostgresRolesSessions {
+		// Search in new list
+		found := funk.Find(newOldRoleSessionsList, func(it2 *v1alpha1.OldPostgresRoleSessions) bool {
+			return it.Role == it2.Role && it.Engine == it2.Engine
+		})
+		// Check if it isn't found
+		if found == nil {
+			r.OldRoleActiveSessions.DeleteLabelValues(instance.Namespace, instance.Name, it.Role, it.Engine)
+			r.OldRoleActiveSessionsAge.DeleteLabelValues(instance.Namespace, instance.Name, it.Role, it.Engine)
 		}
 	}
 
 	// Save new list
 	instance.Status.OldPostgresRoles = funk.UniqString(newOldRoleList)
+	instance.Status.OldPostgresRolesSessions = newOldRoleSessionsList
+
+	// Keep only rotations of remaining old roles
+	newOldRoleRotationsList := make([]*v1alpha1.OldPostgresRoleRotation, 0)
+	for _, it := range instance.Status.OldPostgresRolesRotations {
+		if funk.ContainsString(instance.Status.OldPostgresRoles, it.Role) {
+			newOldRoleRotationsList = append(newOldRoleRotationsList, it)
+		}
+	}
+	// Save
+	instance.Status.OldPostgresRolesRotations = newOldRoleRotationsList
+
+	// Default
+	return nil
+}
+
+// addOldPostgresRole will add role to old roles to clean and will save its rotation time.
+func addOldPostgresRole(instance *v1alpha1.PostgresqlUserRole, role string, now time.Time) {
+	// Add role
+	instance.Status.OldPostgresRoles = append(instance.Status.OldPostgresRoles, role)
+
+	// Check if rotation time is already saved
+	if getOldPostgresRoleRotatedTime(instance, role) != nil {
+		return
+	}
+
+	// Save rotation time
+	instance.Status.OldPostgresRolesRotations = append(instance.Status.OldPostgresRolesRotations, &v1alpha1.OldPostgresRoleRotation{
+		Role:        role,
+		RotatedTime: now.Format(time.RFC3339),
+	})
+}
+
+func getOldPostgresRoleRotatedTime(instance *v1alpha1.PostgresqlUserRole, role string) *time.Time {
+	// Loop over rotations
+	for _, it := range instance.Status.OldPostgresRolesRotations {
+		if it.Role != role {
+			continue
+		}
+
+		// Parse
+		t, err := time.Parse(time.RFC3339, it.RotatedTime)
+		// Check error
+		if err != nil {
+			return nil
+		}
+
+		return &t
+	}
+
+	return nil
+}
+
+// getNextOldRoleTermination will return duration before next old role grace period expiration.
+func getNextOldRoleTermination(instance *v1alpha1.PostgresqlUserRole, now time.Time) time.Duration {
+	var res time.Duration
+
+	// Check if grace period is set
+	if instance.Spec.OldRolesTerminationGracePeriod == "" {
+		return res
+	}
+
+	// Parse grace period
+	// Note: This have been validated before
+	gracePeriod, err := time.ParseDuration(instance.Spec.OldRolesTerminationGracePeriod)
+	// Check error
+	if err != nil {
+		return res
+	}
+
+	// Loop over old roles with active sessions
+	for _, it := range instance.Status.OldPostgresRolesSessions {
+		// Get rotation time
+		graceStart := getOldPostgresRoleRotatedTime(instance, it.Role)
+		// Check if it isn't found
+		if graceStart == nil {
+			// Parse detection time
+			detectedTime, err2 := time.Parse(time.RFC3339, it.DetectedTime)
+			// Check error
+			if err2 != nil {
+				continue
+			}
+
+			graceStart = &detectedTime
+		}
+
+		// Compute duration
+		d := graceStart.Add(gracePeriod).Sub(now)
+		// Ignore already expired ones
+		if d <= 0 {
+			continue
+		}
+
+		// Keep the closest one
+		if res == 0 || d < res {
+			res = d
+		}
+	}
+
+	return res
+}
+
+func (r *PostgresqlUserRoleReconciler) manageSuspension(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlUserRole,
+	pgecCache map[string]*v1alpha1.PostgresqlEngineConfiguration,
+	pgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
+) error {
+	// Create PG instances
+	pgInstancesCache, err := r.getPGInstances(ctx, logger, pgecCache, false)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Build roles list
+	roles := make([]string, 0)
+	if instance.Status.PostgresRole != "" {
+		roles = append(roles, instance.Status.PostgresRole)
+	}
+
+	roles = append(roles, instance.Status.OldPostgresRoles...)
+
+	// Prepare attributes
+	noLogin := false
+	suspendAttributes := &postgres.RoleAttributes{Login: &noLogin}
+
+	// Loop over all pg instances
+	for key, pgInstance := range pgInstancesCache {
+		// Loop over roles
+		for _, role := range roles {
+			// Check if role exists
+			exists, err := pgInstance.IsRoleExist(ctx, role)
+			// Check error
+			if err != nil {
+				return err
+			}
+			// Check if role doesn't exist to ignore it
+			if !exists {
+				continue
+			}
+
+			// Get role attributes
+			sqlAttributes, err := pgInstance.GetRoleAttributes(ctx, role)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if login is still enabled
+			if sqlAttributes.Login == nil || *sqlAttributes.Login {
+				// Alter
+				err = pgInstance.AlterRoleAttributes(ctx, role, suspendAttributes)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				logger.Info("Successfully suspended role in engine", "role", role, "postgresqlEngine", key)
+				r.Recorder.Eventf(instance, "Warning", "Suspended", "Role %s suspended in engine %s", role, key)
+			}
+
+			// Terminate sessions
+			err = r.terminateSuspendedRoleSessions(ctx, pgInstance, pgecCache[key], role)
+			// Check error
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Check if secrets must be blanked
+	if instance.Spec.BlankSecretsWhenSuspended {
+		err = r.blankSecrets(ctx, logger, instance, pgecDBPrivilegeCache)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if it is the first time
+	if instance.Status.SuspendedTime == "" {
+		// Save time
+		instance.Status.SuspendedTime = time.Now().Format(time.RFC3339)
+
+		logger.Info("Successfully suspended user role")
+		r.Recorder.Event(instance, "Warning", "Suspended", "User role suspended")
+	}
+
+	// Default
+	return nil
+}
+
+func (*PostgresqlUserRoleReconciler) terminateSuspendedRoleSessions(
+	ctx context.Context,
+	pgInstance postgres.PG,
+	pgec *v1alpha1.PostgresqlEngineConfiguration,
+	role string,
+) error {
+	// Get main user membership
+	membership, err := pgInstance.GetRoleMembership(ctx, pgInstance.GetUser())
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check if main user is already a member of the role
+	if funk.ContainsString(membership, role) {
+		// Terminate sessions
+		return pgInstance.TerminateRoleSessions(ctx, role)
+	}
+
+	// Some PG instance are limited and this can be done in generic
+	// This limitation needs to add the main user as member of the current role to be able to terminate sessions
+	err = pgInstance.GrantRole(ctx, role, pgInstance.GetUser(), pgec.Spec.AllowGrantAdminOption)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Terminate sessions
+	err = pgInstance.TerminateRoleSessions(ctx, role)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Role is kept, so remove the temporary membership
+	return pgInstance.RevokeRole(ctx, role, pgInstance.GetUser())
+}
+
+func (r *PostgresqlUserRoleReconciler) blankSecrets(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlUserRole,
+	pgecDBPrivilegeCache map[string][]*dbPrivilegeCache,
+) error {
+	// Loop
+	for _, pgecDBPrivilegeList := range pgecDBPrivilegeCache {
+		// Loop over dbs
+		for _, privilegeCache := range pgecDBPrivilegeList {
+			// Get secret
+			secrFound := &corev1.Secret{}
+			err := r.Get(
+				ctx,
+				types.NamespacedName{
+					Name:      privilegeCache.UserPrivilege.GeneratedSecretName,
+					Namespace: instance.Namespace,
+				},
+				secrFound,
+			)
+			// Check error
+			if err != nil {
+				// Ignore not found secrets
+				if errors.IsNotFound(err) {
+					continue
+				}
+
+				return err
+			}
+
+			// Blank values
+			changed := false
+
+			for k, v := range secrFound.Data {
+				if len(v) != 0 {
+					secrFound.Data[k] = []byte{}
+					changed = true
+				}
+			}
+
+			// Check if secret must be saved
+			if changed {
+				// Save secret
+				err = r.Update(ctx, secrFound)
+				// Check error
+				if err != nil {
+					return err
+				}
+
+				logger.Info("Successfully blanked secret", "secret", secrFound.Name)
+				r.Recorder.Eventf(instance, "Warning", "Suspended", "Generated secret %s blanked", secrFound.Name)
+				r.Recorder.Event(secrFound, "Warning", "Suspended", "Secret blanked")
+			}
+		}
+	}
+
+	// Default
+	return nil
+}
+
+func (r *PostgresqlUserRoleReconciler) restoreFromSuspension(
+	ctx context.Context,
+	logger logr.Logger,
+	instance *v1alpha1.PostgresqlUserRole,
+	pgecCache map[string]*v1alpha1.PostgresqlEngineConfiguration,
+) error {
+	// Create PG instances
+	pgInstancesCache, err := r.getPGInstances(ctx, logger, pgecCache, false)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Prepare attributes
+	restoreAttributes := &postgres.RoleAttributes{Login: &postgres.DefaultAttributeLogin}
+
+	// Loop over all pg instances
+	// Note: Current role is restored with role attributes management
+	for key, pgInstance := range pgInstancesCache {
+		// Loop over old roles
+		for _, role := range instance.Status.OldPostgresRoles {
+			// Check if role exists
+			exists, err := pgInstance.IsRoleExist(ctx, role)
+			// Check error
+			if err != nil {
+				return err
+			}
+			// Check if role doesn't exist to ignore it
+			if !exists {
+				continue
+			}
+
+			// Alter
+			err = pgInstance.AlterRoleAttributes(ctx, role, restoreAttributes)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			logger.Info("Successfully restored old role in engine", "role", role, "postgresqlEngine", key)
+		}
+	}
+
+	// Clean status
+	instance.Status.SuspendedTime = ""
+	// Reset rotation reference time to avoid issuing new credentials because rotation duration expired while suspended
+	if instance.Status.LastPasswordChangedTime != "" {
+		instance.Status.LastPasswordChangedTime = time.Now().Format(time.RFC3339)
+	}
+
+	logger.Info("Successfully restored user role from suspension")
+	r.Recorder.Event(instance, "Normal", "Restored", "User role restored from suspension")
 
 	// Default
 	return nil
@@ -1420,16 +2527,72 @@ func (*PostgresqlUserRoleReconciler) validateInstanceWithClusterInfo(
 		if privi.ConnectionType == v1alpha1.BouncerConnectionType && pgec.Spec.UserConnections.BouncerConnection == nil {
 			return errors.NewBadRequest("bouncer connection asked but not supported in engine configuration")
 		}
+
+		// Check dangerous attributes are allowed
+		if instance.Spec.RoleAttributes != nil {
+			// Check CreateDB
+			if instance.Spec.RoleAttributes.CreateDB != nil && *instance.Spec.RoleAttributes.CreateDB &&
+				!funk.Contains(pgec.Spec.AllowedUserRoleAttributes, v1alpha1.CreateDBDangerousUserRoleAttribute) {
+				return errors.NewBadRequest("CREATEDB attribute asked but not allowed in engine configuration")
+			}
+
+			// Check CreateRole
+			if instance.Spec.RoleAttributes.CreateRole != nil && *instance.Spec.RoleAttributes.CreateRole &&
+				!funk.Contains(pgec.Spec.AllowedUserRoleAttributes, v1alpha1.CreateRoleDangerousUserRoleAttribute) {
+				return errors.NewBadRequest("CREATEROLE attribute asked but not allowed in engine configuration")
+			}
+		}
 	}
 
 	// Default
 	return nil
 }
 
+func (*PostgresqlUserRoleReconciler) getPasswordPolicy(
+	instance *v1alpha1.PostgresqlUserRole,
+	pgecCache map[string]*v1alpha1.PostgresqlEngineConfiguration,
+) (*v1alpha1.PasswordPolicy, error) {
+	// Check if password policy is set on instance
+	if instance.Spec.PasswordPolicy != nil {
+		return instance.Spec.PasswordPolicy, nil
+	}
+
+	// Prepare result
+	var res *v1alpha1.PasswordPolicy
+
+	// Loop over pgecs to find a default password policy
+	for _, pgec := range pgecCache {
+		// Check if default password policy is set
+		if pgec.Spec.DefaultPasswordPolicy == nil {
+			continue
+		}
+
+		// Check if another one have already been found and is different
+		if res != nil && !reflect.DeepEqual(res, pgec.Spec.DefaultPasswordPolicy) {
+			return nil, errors.NewBadRequest("engine configurations linked to this user role have different default password policies, a password policy must be set on user role")
+		}
+
+		// Save
+		res = pgec.Spec.DefaultPasswordPolicy
+	}
+
+	return res, nil
+}
+
 func (r *PostgresqlUserRoleReconciler) validateInstance(
 	ctx context.Context,
 	instance *v1alpha1.PostgresqlUserRole,
 ) error {
+	// Check if password policy is set
+	if instance.Spec.PasswordPolicy != nil {
+		// Validate it
+		err := utils.ValidatePasswordPolicy(instance.Spec.PasswordPolicy)
+		// Check error
+		if err != nil {
+			return errors.NewBadRequest(err.Error())
+		}
+	}
+
 	// Validate secret in case of provided mode
 	if instance.Spec.Mode == v1alpha1.ProvidedMode {
 		// Check mode
@@ -1484,6 +2647,71 @@ func (r *PostgresqlUserRoleReconciler) validateInstance(
 		}
 	}
 
+	// Check if valid until margin is set
+	if instance.Spec.RoleAttributes != nil && instance.Spec.RoleAttributes.ValidUntilMargin != "" {
+		// Check that it is used with a password rotation
+		if instance.Spec.Mode != v1alpha1.ManagedMode || instance.Spec.UserPasswordRotationDuration == "" {
+			return errors.NewBadRequest("Valid until margin can only be used in MANAGED mode with a user password rotation duration")
+		}
+
+		// Try to parse duration
+		_, err := time.ParseDuration(instance.Spec.RoleAttributes.ValidUntilMargin)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check if old roles termination grace period is set
+	if instance.Spec.OldRolesTerminationGracePeriod != "" {
+		// Try to parse duration
+		_, err := time.ParseDuration(instance.Spec.OldRolesTerminationGracePeriod)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate table grants
+	for _, privi := range instance.Spec.Privileges {
+		// Check if table grants are set
+		if len(privi.TableGrants) == 0 {
+			continue
+		}
+
+		// Check privilege
+		if privi.Privilege != v1alpha1.CustomPrivilege {
+			return errors.NewBadRequest("Table grants can only be used with CUSTOM privilege")
+		}
+
+		// Check DELETE isn't asked on columns
+		for _, tg := range privi.TableGrants {
+			if len(tg.Columns) != 0 && funk.Contains(tg.Privileges, v1alpha1.DeleteTableGrantPrivilege) {
+				return errors.NewBadRequest("DELETE privilege cannot be granted on columns")
+			}
+		}
+	}
+
+	// Validate privileges expiration
+	for _, privi := range instance.Spec.Privileges {
+		// Check if expiration is set
+		if privi.ExpiresAt == "" {
+			continue
+		}
+
+		// Check privilege
+		if privi.Privilege == v1alpha1.OwnerPrivilege {
+			return errors.NewBadRequest("Privilege expiration can only be used with READER and WRITER privileges")
+		}
+
+		// Try to parse time
+		_, err := time.Parse(time.RFC3339, privi.ExpiresAt)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
 	// Validate not multiple time the same db in the list of privileges
 	for i, privi := range instance.Spec.Privileges {
 		// Prepare values
@@ -1611,6 +2839,20 @@ func (r *PostgresqlUserRoleReconciler) manageSuccess(
 	instance.Status.Message = ""
 	instance.Status.Ready = true
 	instance.Status.Phase = v1alpha1.UserRoleCreatedPhase
+	// Check if user role is suspended
+	if instance.Spec.Suspended {
+		instance.Status.Phase = v1alpha1.UserRoleSuspendedPhase
+	}
+
+	// Save now
+	now := time.Now()
+
+	// Compute next privilege expiration to requeue exactly at this time
+	requeueAfter := getNextPrivilegeExpiration(instance, now)
+	// Compute next old role grace period expiration to terminate sessions on time
+	if d := getNextOldRoleTermination(instance, now); d != 0 && (requeueAfter == 0 || d < requeueAfter) {
+		requeueAfter = d
+	}
 
 	// Patch status
 	err := r.Status().Patch(ctx, instance, originalPatch)
@@ -1626,7 +2868,7 @@ func (r *PostgresqlUserRoleReconciler) manageSuccess(
 
 	logger.Info("Reconcile done")
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
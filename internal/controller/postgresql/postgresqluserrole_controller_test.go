@@ -9,6 +9,7 @@ import (
 	"github.com/easymile/postgresql-operator/api/postgresql/common"
 	"github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
 	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -495,6 +496,73 @@ var _ = Describe("PostgresqlUserRole tests", func() {
 			Expect(item.Status.Message).To(Equal("Username is too long. It must be <= 63. fakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefakefake is 96 character. Username length must be reduced"))
 		})
 
+		It("should fail when import secret password isn't compliant with password policy", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			// Create secret
+			setupPGURImportSecret()
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:             postgresqlv1alpha1.ProvidedMode,
+					ImportSecretName: pgurImportSecretName,
+					PasswordPolicy: &postgresqlv1alpha1.PasswordPolicy{
+						Length: 50,
+						CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+							postgresqlv1alpha1.LowercasePasswordCharacterClass,
+						},
+					},
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("Import secret password isn't compliant with password policy: password must have at least 50 characters"))
+		})
+
 		It("should fail to look a not found pgdb", func() {
 			// Create secret
 			setupPGURImportSecret()
@@ -3180,6 +3248,174 @@ var _ = Describe("PostgresqlUserRole tests", func() {
 			Expect(item.Status.Message).To(Equal(`time: invalid duration "fake"`))
 		})
 
+		It("should fail when OldRolesTerminationGracePeriod isn't a valid duration", func() {
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                           postgresqlv1alpha1.ManagedMode,
+					RolePrefix:                     pgurRolePrefix,
+					OldRolesTerminationGracePeriod: "fake",
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal(`time: invalid duration "fake"`))
+		})
+
+		It("should fail when valid until margin is set without user password rotation duration", func() {
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:       postgresqlv1alpha1.ManagedMode,
+					RolePrefix: pgurRolePrefix,
+					RoleAttributes: &postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+						ValidUntilMargin: "1h",
+					},
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("Valid until margin can only be used in MANAGED mode with a user password rotation duration"))
+		})
+
+		It("should fail when password policy have all characters of a class excluded", func() {
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:       postgresqlv1alpha1.ManagedMode,
+					RolePrefix: pgurRolePrefix,
+					PasswordPolicy: &postgresqlv1alpha1.PasswordPolicy{
+						Length:             20,
+						CharacterClasses:   []postgresqlv1alpha1.PasswordCharacterClass{postgresqlv1alpha1.DigitsPasswordCharacterClass},
+						ExcludedCharacters: "0123456789",
+					},
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("password policy character class DIGITS have all characters excluded"))
+		})
+
 		It("should fail to look a not found pgdb", func() {
 			it := &postgresqlv1alpha1.PostgresqlUserRole{
 				ObjectMeta: v1.ObjectMeta{
@@ -3594,30 +3830,1125 @@ var _ = Describe("PostgresqlUserRole tests", func() {
 			}))
 		})
 
-		It("should be ok with custom attributes", func() {
+		It("should fail when CREATEDB attribute isn't allowed in engine configuration", func() {
 			// Setup pgec
-			pgec, _ := setupPGEC("30s", false)
+			setupPGEC("30s", false)
 			// Create pgdb
-			pgdb := setupPGDB(false)
-
-			preDate := time.Now().Add(-time.Second)
+			setupPGDB(false)
 
-			item := setupManagedPGURWithPartialCustomAttributes()
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:       postgresqlv1alpha1.ManagedMode,
+					RolePrefix: pgurRolePrefix,
+					RoleAttributes: &postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+						CreateDB: starAny(true),
+					},
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
 
-			username := pgurRolePrefix + Login0Suffix
-			// Checks
-			Expect(item.Status.Ready).To(BeTrue())
-			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
-			Expect(item.Status.Message).To(Equal(""))
-			Expect(item.Status.RolePrefix).To(Equal(pgurRolePrefix))
-			Expect(item.Status.PostgresRole).To(Equal(username))
-			Expect(item.Spec.WorkGeneratedSecretName).To(Equal(pgurWorkSecretName))
-			Expect(item.Spec.Privileges[0].ConnectionType).To(Equal(postgresqlv1alpha1.PrimaryConnectionType))
-			d, err := time.Parse(time.RFC3339, item.Status.LastPasswordChangedTime)
-			Expect(err).To(Succeed())
-			Expect(d.After(preDate)).To(BeTrue())
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
 
-			// Get work secret
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("CREATEDB attribute asked but not allowed in engine configuration"))
+		})
+
+		It("should be ok with extended attributes allowed in engine configuration", func() {
+			// Setup pgec
+			pgec, _ := setupPGEC("30s", false)
+			// Allow dangerous attributes
+			updatePGECAllowedUserRoleAttributes(pgec, []postgresqlv1alpha1.DangerousUserRoleAttribute{
+				postgresqlv1alpha1.CreateDBDangerousUserRoleAttribute,
+				postgresqlv1alpha1.CreateRoleDangerousUserRoleAttribute,
+			})
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGURWithRoleAttributes(&postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+				CreateDB:   starAny(true),
+				CreateRole: starAny(true),
+				Inherit:    starAny(false),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+
+			attr, err := getRoleExtendedAttributes(item.Status.PostgresRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attr).To(Equal(&RoleExtendedAttributes{
+				CreateDB:   starAny(true),
+				CreateRole: starAny(true),
+				Inherit:    starAny(false),
+				Login:      starAny(true),
+			}))
+		})
+
+		It("should be ok to suspend login with login attribute", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGURWithRoleAttributes(&postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+				Login: starAny(false),
+			})
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			attr, err := getRoleExtendedAttributes(item.Status.PostgresRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attr.Login).To(Equal(starAny(false)))
+
+			// Get work secret
+			sec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Spec.WorkGeneratedSecretName,
+				Namespace: pgurNamespace,
+			}, sec)).Should(Succeed())
+
+			// Connect must fail
+			_, err = connectAs(item.Status.PostgresRole, string(sec.Data[PasswordSecretKey]))
+			Expect(err).To(HaveOccurred())
+
+			// Restore login
+			item.Spec.RoleAttributes = nil
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					attr, err := getRoleExtendedAttributes(item.Status.PostgresRole)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if !*attr.Login {
+						return errors.New("login attribute hasn't been restored")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Connect must be ok
+			_, err = connectAs(item.Status.PostgresRole, string(sec.Data[PasswordSecretKey]))
+			Expect(err).To(Succeed())
+		})
+
+		It("should be ok to revoke a privilege from a user role without login", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdbs
+			pgdb := setupPGDB(false)
+			pgdb2 := setupPGDB2()
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                    postgresqlv1alpha1.ManagedMode,
+					RolePrefix:              pgurRolePrefix,
+					WorkGeneratedSecretName: pgurWorkSecretName,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+						{
+							Privilege:           postgresqlv1alpha1.ReaderPrivilege,
+							Database:            &common.CRLink{Name: pgdbName2, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName2,
+						},
+					},
+					RoleAttributes: &postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+						Login: starAny(false),
+					},
+				},
+			}
+
+			item := setupSavePGURInternal(it)
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			members, err := getSQLRoleMembershipWithAdminOption(pgdb2.Status.Roles.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(members).To(HaveKey(item.Status.PostgresRole))
+
+			// Remove reader privilege
+			item.Spec.Privileges = item.Spec.Privileges[:1]
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					members, err := getSQLRoleMembershipWithAdminOption(pgdb2.Status.Roles.Reader)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if _, ok := members[item.Status.PostgresRole]; ok {
+						return errors.New("reader privilege hasn't been revoked")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Owner privilege must be kept
+			members, err = getSQLRoleMembershipWithAdminOption(pgdb.Status.Roles.Owner)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(members).To(HaveKey(item.Status.PostgresRole))
+
+			sett, err := isSetRoleOnDatabasesRoleSettingsExists(item.Status.PostgresRole, pgdbDBName, pgdb.Status.Roles.Owner)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sett).To(BeTrue())
+		})
+
+		It("should be ok to suspend and restore user role", func() {
+			// Setup pgec
+			pgec, _ := setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGUR("")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			username := item.Status.PostgresRole
+
+			// Get work secret
+			sec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Spec.WorkGeneratedSecretName,
+				Namespace: pgurNamespace,
+			}, sec)).Should(Succeed())
+
+			password := string(sec.Data[PasswordSecretKey])
+
+			// Connect to check user
+			_, err := connectAs(username, password)
+			Expect(err).To(Succeed())
+
+			// Suspend
+			item.Spec.Suspended = true
+			item.Spec.BlankSecretsWhenSuspended = true
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase != postgresqlv1alpha1.UserRoleSuspendedPhase {
+						return errors.New("pgur hasn't been suspended by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.SuspendedTime).ToNot(Equal(""))
+			Expect(item.Status.PostgresRole).To(Equal(username))
+
+			attr, err := getRoleExtendedAttributes(username)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attr.Login).To(Equal(starAny(false)))
+
+			// Get db secret
+			dbsec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      pgurDBSecretName,
+				Namespace: pgurNamespace,
+			}, dbsec)).Should(Succeed())
+
+			for _, v := range dbsec.Data {
+				Expect(v).To(BeEmpty())
+			}
+
+			// Connect must fail
+			_, err = connectAs(username, password)
+			Expect(err).To(HaveOccurred())
+
+			// Restore
+			item.Spec.Suspended = false
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase != postgresqlv1alpha1.UserRoleCreatedPhase || item.Status.SuspendedTime != "" {
+						return errors.New("pgur hasn't been restored by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.PostgresRole).To(Equal(username))
+
+			// Validate secret have been restored with the same credentials
+			checkPGURSecretValues(pgurDBSecretName, pgurNamespace, pgdbDBName, username, password, pgec, v1alpha1.PrimaryConnectionType)
+
+			// Connect must be ok
+			_, err = connectAs(username, password)
+			Expect(err).To(Succeed())
+		})
+
+		It("should keep credentials on restore when rotation duration expired while suspended", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGUR("5s")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			// Suspend
+			item.Spec.Suspended = true
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase != postgresqlv1alpha1.UserRoleSuspendedPhase {
+						return errors.New("pgur hasn't been suspended by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			username := item.Status.PostgresRole
+
+			// Wait for rotation duration to be expired
+			time.Sleep(6 * time.Second)
+
+			preDate := time.Now().Add(-time.Second)
+
+			// Restore
+			item.Spec.Suspended = false
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase != postgresqlv1alpha1.UserRoleCreatedPhase || item.Status.SuspendedTime != "" {
+						return errors.New("pgur hasn't been restored by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.PostgresRole).To(Equal(username))
+			Expect(item.Status.OldPostgresRoles).To(BeEmpty())
+
+			d, err := time.Parse(time.RFC3339, item.Status.LastPasswordChangedTime)
+			Expect(err).To(Succeed())
+			Expect(d.After(preDate)).To(BeTrue())
+		})
+
+		It("should fail when privilege expiration is set on an owner privilege", func() {
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:       postgresqlv1alpha1.ManagedMode,
+					RolePrefix: pgurRolePrefix,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+							ExpiresAt:           time.Now().Add(time.Hour).Format(time.RFC3339),
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("Privilege expiration can only be used with READER and WRITER privileges"))
+		})
+
+		It("should revoke a time-boxed privilege at expiration", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                    postgresqlv1alpha1.ManagedMode,
+					RolePrefix:              pgurRolePrefix,
+					WorkGeneratedSecretName: pgurWorkSecretName,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.ReaderPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+							ExpiresAt:           time.Now().Add(10 * time.Second).Format(time.RFC3339),
+						},
+					},
+				},
+			}
+
+			item := setupSavePGURInternal(it)
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			readerMembers, err := getSQLRoleMembershipWithAdminOption(pgdb.Status.Roles.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readerMembers).To(HaveKey(username))
+
+			// Get db secret
+			dbsec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      pgurDBSecretName,
+				Namespace: pgurNamespace,
+			}, dbsec)).Should(Succeed())
+
+			// Wait for expiration
+			Eventually(
+				func() error {
+					readerMembers, err := getSQLRoleMembershipWithAdminOption(pgdb.Status.Roles.Reader)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if _, ok := readerMembers[username]; ok {
+						return errors.New("privilege hasn't been revoked")
+					}
+
+					return nil
+				},
+				30*time.Second,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Db secret must have been removed
+			Eventually(
+				func() error {
+					_, err := getSecret(ctx, k8sClient, pgurDBSecretName, pgurNamespace)
+
+					if err == nil {
+						return errors.New("secret still present")
+					}
+
+					if !apimachineryErrors.IsNotFound(err) {
+						return err
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+		})
+
+		It("should fail when table grants are set on a non custom privilege", func() {
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:       postgresqlv1alpha1.ManagedMode,
+					RolePrefix: pgurRolePrefix,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.ReaderPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+							TableGrants: []*postgresqlv1alpha1.PostgresqlUserRoleTableGrant{
+								{
+									Table:      "fake",
+									Privileges: []postgresqlv1alpha1.TableGrantPrivilege{postgresqlv1alpha1.SelectTableGrantPrivilege},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// Create user
+			Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+			item := &postgresqlv1alpha1.PostgresqlUserRole{}
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.Phase == postgresqlv1alpha1.UserRoleNoPhase {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item.Status.Ready).To(BeFalse())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleFailedPhase))
+			Expect(item.Status.Message).To(Equal("Table grants can only be used with CUSTOM privilege"))
+		})
+
+		It("should be ok with table and column grants", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			// Create tables
+			Expect(create2KnownTablesWithColumnsInPublicSchema()).To(Succeed())
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                    postgresqlv1alpha1.ManagedMode,
+					RolePrefix:              pgurRolePrefix,
+					WorkGeneratedSecretName: pgurWorkSecretName,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.CustomPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+							TableGrants: []*postgresqlv1alpha1.PostgresqlUserRoleTableGrant{
+								{
+									Table: "fake",
+									Privileges: []postgresqlv1alpha1.TableGrantPrivilege{
+										postgresqlv1alpha1.SelectTableGrantPrivilege,
+										postgresqlv1alpha1.InsertTableGrantPrivilege,
+									},
+								},
+								{
+									Table:      "fake2",
+									Privileges: []postgresqlv1alpha1.TableGrantPrivilege{postgresqlv1alpha1.SelectTableGrantPrivilege},
+									Columns:    []string{"id"},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			item := setupSavePGURInternal(it)
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			// User mustn't be member of any group role
+			for _, role := range []string{pgdb.Status.Roles.Owner, pgdb.Status.Roles.Writer, pgdb.Status.Roles.Reader} {
+				members, err := getSQLRoleMembershipWithAdminOption(role)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(members).ToNot(HaveKey(username))
+			}
+
+			sett, err := isSetRoleOnDatabasesRoleSettingsExists(username, pgdbDBName, pgdb.Status.Roles.Owner)
+			Expect(err).To(Succeed())
+			Expect(sett).To(BeFalse())
+
+			checks := map[string]bool{
+				fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'SELECT')", username):           true,
+				fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'INSERT')", username):           true,
+				fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'DELETE')", username):           false,
+				fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake2', 'SELECT')", username):          false,
+				fmt.Sprintf("SELECT has_column_privilege('%s', 'public.fake2', 'id', 'SELECT')", username):   true,
+				fmt.Sprintf("SELECT has_column_privilege('%s', 'public.fake2', 'test', 'SELECT')", username): false,
+			}
+			for q, expected := range checks {
+				res, err := rawSQLQueryBoolInDB(q)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(expected), q)
+			}
+
+			// Remove INSERT and column grant
+			item.Spec.Privileges[0].TableGrants = []*postgresqlv1alpha1.PostgresqlUserRoleTableGrant{
+				{
+					Schema:     "public",
+					Table:      "fake",
+					Privileges: []postgresqlv1alpha1.TableGrantPrivilege{postgresqlv1alpha1.SelectTableGrantPrivilege},
+				},
+			}
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					res, err := rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'INSERT')", username))
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if res {
+						return errors.New("insert privilege hasn't been revoked")
+					}
+
+					res, err = rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_column_privilege('%s', 'public.fake2', 'id', 'SELECT')", username))
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if res {
+						return errors.New("column privilege hasn't been revoked")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			res, err := rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'SELECT')", username))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should revoke table grants when custom privilege is removed", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdbs
+			setupPGDB(false)
+			setupPGDB2()
+
+			// Create tables
+			Expect(create2KnownTablesWithColumnsInPublicSchema()).To(Succeed())
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                    postgresqlv1alpha1.ManagedMode,
+					RolePrefix:              pgurRolePrefix,
+					WorkGeneratedSecretName: pgurWorkSecretName,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.CustomPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+							TableGrants: []*postgresqlv1alpha1.PostgresqlUserRoleTableGrant{
+								{
+									Table:      "fake",
+									Privileges: []postgresqlv1alpha1.TableGrantPrivilege{postgresqlv1alpha1.SelectTableGrantPrivilege},
+								},
+								{
+									Table:      "fake2",
+									Privileges: []postgresqlv1alpha1.TableGrantPrivilege{postgresqlv1alpha1.SelectTableGrantPrivilege},
+									Columns:    []string{"id"},
+								},
+							},
+						},
+						{
+							Privilege:           postgresqlv1alpha1.ReaderPrivilege,
+							Database:            &common.CRLink{Name: pgdbName2, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName2,
+						},
+					},
+				},
+			}
+
+			item := setupSavePGURInternal(it)
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.TableGrantDatabases).To(Equal([]*postgresqlv1alpha1.UserRoleTableGrantDatabase{
+				{
+					Engine:   utils.CreateNameKey(pgecName, pgecNamespace, pgdbNamespace),
+					Database: pgdbDBName,
+					Grants: []*postgresqlv1alpha1.UserRoleAppliedTableGrant{
+						{Schema: "public", Table: "fake", Privilege: "SELECT"},
+						{Schema: "public", Table: "fake2", Column: "id", Privilege: "SELECT"},
+					},
+				},
+			}))
+
+			res, err := rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'SELECT')", username))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeTrue())
+
+			// Remove custom privilege
+			item.Spec.Privileges = item.Spec.Privileges[1:]
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					res, err := rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'SELECT')", username))
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if res {
+						return errors.New("table privilege hasn't been revoked")
+					}
+
+					res, err = rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_column_privilege('%s', 'public.fake2', 'id', 'SELECT')", username))
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if res {
+						return errors.New("column privilege hasn't been revoked")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Get updated user
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pgurName, Namespace: pgurNamespace}, item)).To(Succeed())
+			Expect(item.Status.TableGrantDatabases).To(BeEmpty())
+		})
+
+		It("shouldn't revoke table grants not applied by operator", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdbs
+			setupPGDB(false)
+			pgdb2 := setupPGDB2()
+
+			// Create tables
+			Expect(create2KnownTablesWithColumnsInPublicSchema()).To(Succeed())
+
+			it := &postgresqlv1alpha1.PostgresqlUserRole{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      pgurName,
+					Namespace: pgurNamespace,
+				},
+				Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+					Mode:                    postgresqlv1alpha1.ManagedMode,
+					RolePrefix:              pgurRolePrefix,
+					WorkGeneratedSecretName: pgurWorkSecretName,
+					Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+						{
+							Privilege:           postgresqlv1alpha1.ReaderPrivilege,
+							Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+							GeneratedSecretName: pgurDBSecretName,
+						},
+					},
+				},
+			}
+
+			item := setupSavePGURInternal(it)
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.TableGrantDatabases).To(BeEmpty())
+
+			// Grant privilege manually
+			Expect(rawSQLQuery(fmt.Sprintf(`GRANT INSERT ON public.fake TO "%s"`, username))).To(Succeed())
+
+			// Add a writer privilege to trigger a reconcile
+			item.Spec.Privileges = append(item.Spec.Privileges, &postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+				Privilege:           postgresqlv1alpha1.WriterPrivilege,
+				Database:            &common.CRLink{Name: pgdbName2, Namespace: pgdbNamespace},
+				GeneratedSecretName: pgurDBSecretName2,
+			})
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			Eventually(
+				func() error {
+					members, err := getSQLRoleMembershipWithAdminOption(pgdb2.Status.Roles.Writer)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if _, ok := members[username]; !ok {
+						return errors.New("writer privilege hasn't been applied")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Manual grant must be kept
+			res, err := rawSQLQueryBoolInDB(fmt.Sprintf("SELECT has_table_privilege('%s', 'public.fake', 'INSERT')", username))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeTrue())
+		})
+
+		It("should be ok with valid until margin", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGURWithValidUntilMargin("1h", "2h")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.PasswordExpirationTime).ToNot(Equal(""))
+
+			lastChange, err := time.Parse(time.RFC3339, item.Status.LastPasswordChangedTime)
+			Expect(err).ToNot(HaveOccurred())
+			expiration, err := time.Parse(time.RFC3339, item.Status.PasswordExpirationTime)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiration.Equal(lastChange.Add(3 * time.Hour))).To(BeTrue())
+
+			validUntil, err := getRoleValidUntil(item.Status.PostgresRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validUntil).ToNot(BeNil())
+			Expect(validUntil.Equal(expiration)).To(BeTrue())
+		})
+
+		It("should reset valid until when margin is removed", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGURWithValidUntilMargin("1h", "2h")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.PasswordExpirationTime).ToNot(Equal(""))
+
+			// Remove margin
+			item.Spec.RoleAttributes = nil
+			Expect(k8sClient.Update(ctx, item)).To(Succeed())
+
+			// Get updated user
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					// Check if status hasn't been updated
+					if item.Status.PasswordExpirationTime != "" {
+						return errors.New("pgur hasn't been updated by operator")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			validUntil, err := getRoleValidUntil(item.Status.PostgresRole)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validUntil).To(BeNil())
+		})
+
+		It("should be ok with password policy", func() {
+			// Setup pgec
+			pgec, _ := setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			policy := &postgresqlv1alpha1.PasswordPolicy{
+				Length: 40,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+					postgresqlv1alpha1.SpecialPasswordCharacterClass,
+				},
+				ExcludedCharacters: "0Ol1",
+			}
+
+			item := setupManagedPGURWithPasswordPolicy(policy)
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.PostgresRole).To(Equal(username))
+
+			// Get work secret
+			sec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Spec.WorkGeneratedSecretName,
+				Namespace: pgurNamespace,
+			}, sec)).Should(Succeed())
+
+			password := string(sec.Data[PasswordSecretKey])
+			Expect(password).To(HaveLen(40))
+			Expect(password).ToNot(ContainSubstring("0"))
+			Expect(password).ToNot(ContainSubstring("l"))
+			Expect(utils.ValidatePasswordWithPolicy(password, policy)).To(Succeed())
+
+			// Validate
+			checkPGURSecretValues(pgurDBSecretName, pgurNamespace, pgdbDBName, username, password, pgec, v1alpha1.PrimaryConnectionType)
+
+			// Connect to check user
+			_, err := connectAs(username, password)
+			Expect(err).To(Succeed())
+		})
+
+		It("should be ok with engine configuration default password policy", func() {
+			// Setup pgec
+			pgec, _ := setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			policy := &postgresqlv1alpha1.PasswordPolicy{
+				Length: 25,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.UppercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+				},
+			}
+
+			// Update pgec to add default password policy
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgec.Name,
+						Namespace: pgec.Namespace,
+					}, pgec)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					pgec.Spec.DefaultPasswordPolicy = policy
+
+					return k8sClient.Update(ctx, pgec)
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			item := setupManagedPGUR("")
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+
+			// Get work secret
+			sec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Spec.WorkGeneratedSecretName,
+				Namespace: pgurNamespace,
+			}, sec)).Should(Succeed())
+
+			password := string(sec.Data[PasswordSecretKey])
+			Expect(password).To(HaveLen(25))
+			Expect(utils.ValidatePasswordWithPolicy(password, policy)).To(Succeed())
+
+			// Connect to check user
+			_, err := connectAs(item.Status.PostgresRole, password)
+			Expect(err).To(Succeed())
+		})
+
+		It("should be ok with custom attributes", func() {
+			// Setup pgec
+			pgec, _ := setupPGEC("30s", false)
+			// Create pgdb
+			pgdb := setupPGDB(false)
+
+			preDate := time.Now().Add(-time.Second)
+
+			item := setupManagedPGURWithPartialCustomAttributes()
+
+			username := pgurRolePrefix + Login0Suffix
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.Message).To(Equal(""))
+			Expect(item.Status.RolePrefix).To(Equal(pgurRolePrefix))
+			Expect(item.Status.PostgresRole).To(Equal(username))
+			Expect(item.Spec.WorkGeneratedSecretName).To(Equal(pgurWorkSecretName))
+			Expect(item.Spec.Privileges[0].ConnectionType).To(Equal(postgresqlv1alpha1.PrimaryConnectionType))
+			d, err := time.Parse(time.RFC3339, item.Status.LastPasswordChangedTime)
+			Expect(err).To(Succeed())
+			Expect(d.After(preDate)).To(BeTrue())
+
+			// Get work secret
 			sec := &corev1.Secret{}
 			Expect(k8sClient.Get(ctx, types.NamespacedName{
 				Name:      item.Spec.WorkGeneratedSecretName,
@@ -5299,6 +6630,111 @@ var _ = Describe("PostgresqlUserRole tests", func() {
 				Should(Succeed())
 		})
 
+		It("should be ok to have rolling password enabled and performed with old user still connected and terminated after grace period", func() {
+			// Setup pgec
+			setupPGEC("30s", false)
+			// Create pgdb
+			setupPGDB(false)
+
+			item := setupManagedPGURWithOldRolesTerminationGracePeriod("15s", "5s")
+
+			username := pgurRolePrefix + Login0Suffix
+			username2 := pgurRolePrefix + Login1Suffix
+
+			// Checks
+			Expect(item.Status.Ready).To(BeTrue())
+			Expect(item.Status.Phase).To(Equal(postgresqlv1alpha1.UserRoleCreatedPhase))
+			Expect(item.Status.PostgresRole).To(Equal(username))
+			Expect(item.Status.OldPostgresRoles).To(Equal([]string{}))
+			Expect(item.Status.OldPostgresRolesSessions).To(BeEmpty())
+
+			// Get work secret
+			workSec := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      item.Spec.WorkGeneratedSecretName,
+				Namespace: pgurNamespace,
+			}, workSec)).Should(Succeed())
+
+			// Connect
+			_, err := connectAs(username, string(workSec.Data[PasswordSecretKey]))
+			Expect(err).To(Succeed())
+
+			item2 := &postgresqlv1alpha1.PostgresqlUserRole{}
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item2)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if len(item2.Status.OldPostgresRolesSessions) == 0 {
+						return errors.New("pgur not updated")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item2.Status.Ready).To(BeTrue())
+			Expect(item2.Status.PostgresRole).To(Equal(username2))
+			Expect(item2.Status.OldPostgresRoles).To(Equal([]string{username}))
+			Expect(item2.Status.OldPostgresRolesSessions).To(HaveLen(1))
+			Expect(item2.Status.OldPostgresRolesSessions[0].Role).To(Equal(username))
+			Expect(item2.Status.OldPostgresRolesSessions[0].Engine).To(Equal(utils.CreateNameKey(pgecName, pgecNamespace, pgdbNamespace)))
+			Expect(item2.Status.OldPostgresRolesSessions[0].ActiveSessions).To(Equal(1))
+			Expect(item2.Status.OldPostgresRolesSessions[0].DetectedTime).ToNot(Equal(""))
+			Expect(item2.Status.OldPostgresRolesSessions[0].OldestSessionStartTime).ToNot(Equal(""))
+			// Grace period must start at rotation
+			Expect(item2.Status.OldPostgresRolesRotations).To(HaveLen(1))
+			Expect(item2.Status.OldPostgresRolesRotations[0].Role).To(Equal(username))
+			Expect(item2.Status.OldPostgresRolesRotations[0].RotatedTime).To(Equal(item2.Status.LastPasswordChangedTime))
+
+			exists, err := isSQLRoleExists(username)
+			Expect(err).To(Succeed())
+			Expect(exists).To(BeTrue())
+
+			// Wait for grace period to be expired
+			item3 := &postgresqlv1alpha1.PostgresqlUserRole{}
+			Eventually(
+				func() error {
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pgurName,
+						Namespace: pgurNamespace,
+					}, item3)
+					// Check error
+					if err != nil {
+						return err
+					}
+
+					if len(item3.Status.OldPostgresRoles) != 0 {
+						return errors.New("pgur old roles not cleaned")
+					}
+
+					return nil
+				},
+				generalEventuallyTimeout,
+				generalEventuallyInterval,
+			).
+				Should(Succeed())
+
+			// Checks
+			Expect(item3.Status.Ready).To(BeTrue())
+			Expect(item3.Status.OldPostgresRolesSessions).To(BeEmpty())
+			Expect(item3.Status.OldPostgresRolesRotations).To(BeEmpty())
+
+			exists, err = isSQLRoleExists(username)
+			Expect(err).To(Succeed())
+			Expect(exists).To(BeFalse())
+		})
+
 		It("should fail to have rolling password enabled and performed 2 times with 2 users connected", func() {
 			// Setup pgec
 			setupPGEC("30s", false)
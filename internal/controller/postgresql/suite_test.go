@@ -19,6 +19,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	gerrors "errors"
 	"fmt"
 	"path/filepath"
@@ -32,6 +33,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/prometheus/client_golang/prometheus"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -67,6 +69,22 @@ var pgpublicationNamespace = "pgpub-ns"
 var pgpublicationName = "pgpub-object"
 var pgpublicationPublicationName1 = "pub1"
 var pgpublicationCustomReplicationSlotName = "replslotname"
+var pgpublicationCDCSecretName = "pgpub-cdc-secret"
+var pgreplicationslotNamespace = "pgslot-ns"
+var pgreplicationslotName = "pgslot-object"
+var pgreplicationslotSlotName = "operatorslot"
+var pgsequencesyncName = "pgseqsync-object"
+var pgrlspolicyNamespace = "pgrls-ns"
+var pgrlspolicyName = "pgrls-object"
+var pgrlspolicyPolicyName1 = "policy1"
+var pgrlspolicyPolicyName2 = "policy2"
+var pgmigrationNamespace = "pgmig-ns"
+var pgmigrationName = "pgmig-object"
+var pgmigrationSourceName = "pgmig-source"
+var pgbackupNamespace = "pgbkp-ns"
+var pgbackupName = "pgbkp-object"
+var pgbackupScheduleName = "pgbkps-object"
+var pgbackupClaimName = "pgbkp-claim"
 var pgecNamespace = "pgec-ns"
 var pgecName = "pgec-object"
 var pgecSecretName = "pgec-secret"
@@ -109,6 +127,41 @@ var controllerRuntimeDetailedErrorTotal = prometheus.NewCounterVec(
 	},
 	[]string{"controller", "namespace", "name"},
 )
+var userRoleOldRoleActiveSessions = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgresqluserrole_old_role_active_sessions",
+		Help: "Number of active sessions still opened with an old role waiting for deletion.",
+	},
+	[]string{"namespace", "name", "role", "engine"},
+)
+var userRoleOldRoleActiveSessionsAge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgresqluserrole_old_role_active_sessions_age_seconds",
+		Help: "Time in seconds since active sessions have been detected on an old role waiting for deletion.",
+	},
+	[]string{"namespace", "name", "role", "engine"},
+)
+var publicationReplicationSlotActive = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgresqlpublication_replication_slot_active",
+		Help: "Is a consumer connected to the publication replication slot (1 for active, 0 for inactive).",
+	},
+	[]string{"namespace", "name", "slot", "engine"},
+)
+var publicationReplicationSlotRetainedWALBytes = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgresqlpublication_replication_slot_retained_wal_bytes",
+		Help: "WAL size in bytes retained by the publication replication slot.",
+	},
+	[]string{"namespace", "name", "slot", "engine"},
+)
+var publicationReplicationSlotConfirmedFlushLag = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "postgresqlpublication_replication_slot_confirmed_flush_lag_bytes",
+		Help: "WAL size in bytes not yet confirmed by the publication replication slot consumer.",
+	},
+	[]string{"namespace", "name", "slot", "engine"},
+)
 
 func TestControllers(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -176,6 +229,8 @@ var _ = BeforeSuite(func(_ context.Context) {
 		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
 		Scheme:                              scheme.Scheme,
 		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		OldRoleActiveSessions:               userRoleOldRoleActiveSessions,
+		OldRoleActiveSessionsAge:            userRoleOldRoleActiveSessionsAge,
 		ControllerName:                      "postgresqluserrole",
 		ReconcileTimeout:                    10 * time.Second,
 	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
@@ -186,10 +241,73 @@ var _ = BeforeSuite(func(_ context.Context) {
 		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
 		Scheme:                              scheme.Scheme,
 		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ReplicationSlotActive:               publicationReplicationSlotActive,
+		ReplicationSlotRetainedWALBytes:     publicationReplicationSlotRetainedWALBytes,
+		ReplicationSlotConfirmedFlushLag:    publicationReplicationSlotConfirmedFlushLag,
 		ControllerName:                      "postgresqlpublication",
 		ReconcileTimeout:                    10 * time.Second,
 	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
 
+	Expect((&PostgresqlRowLevelSecurityPolicyReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlrowlevelsecuritypolicy",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
+	Expect((&PostgresqlMigrationReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlmigration",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
+	Expect((&PostgresqlBackupReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlbackup",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
+	Expect((&PostgresqlBackupScheduleReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlbackupschedule",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
+	Expect((&PostgresqlReplicationSlotReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlreplicationslot",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
+	Expect((&PostgresqlSequenceSyncReconciler{
+		Client:                              k8sClient,
+		Log:                                 logf.Log.WithName("controllers"),
+		Recorder:                            k8sManager.GetEventRecorderFor("controller"),
+		Scheme:                              scheme.Scheme,
+		ControllerRuntimeDetailedErrorTotal: controllerRuntimeDetailedErrorTotal,
+		ControllerName:                      "postgresqlsequencesync",
+		ReconcileTimeout:                    10 * time.Second,
+	}).SetupWithManager(k8sManager)).ToNot(HaveOccurred())
+
 	go func() {
 		defer GinkgoRecover()
 		err = k8sManager.Start(ctx)
@@ -225,6 +343,30 @@ var _ = BeforeSuite(func(_ context.Context) {
 			Name: pgpublicationNamespace,
 		},
 	})).ToNot(HaveOccurred())
+
+	Expect(k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: pgrlspolicyNamespace,
+		},
+	})).ToNot(HaveOccurred())
+
+	Expect(k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: pgmigrationNamespace,
+		},
+	})).ToNot(HaveOccurred())
+
+	Expect(k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: pgbackupNamespace,
+		},
+	})).ToNot(HaveOccurred())
+
+	Expect(k8sClient.Create(ctx, &corev1.Namespace{
+		ObjectMeta: v1.ObjectMeta{
+			Name: pgreplicationslotNamespace,
+		},
+	})).ToNot(HaveOccurred())
 }, NodeTimeout(60*time.Second))
 
 var _ = AfterSuite(func() {
@@ -258,9 +400,16 @@ func cleanupFunction() {
 	Expect(err).ToNot(HaveOccurred())
 
 	Expect(deletePGPublication(ctx, k8sClient, pgpublicationName, pgpublicationNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGRLSPolicy(ctx, k8sClient, pgrlspolicyName, pgrlspolicyNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGMigration(ctx, k8sClient, pgmigrationName, pgmigrationNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGReplicationSlot(ctx, k8sClient, pgreplicationslotName, pgreplicationslotNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGSequenceSync(ctx, k8sClient, pgsequencesyncName, pgpublicationNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGBackupSchedule(ctx, k8sClient, pgbackupScheduleName, pgbackupNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGBackup(ctx, k8sClient, pgbackupName, pgbackupNamespace)).ToNot(HaveOccurred())
 	Expect(deletePGUR(ctx, k8sClient, pgurName, pgurNamespace)).ToNot(HaveOccurred())
 	Expect(deletePGDB(ctx, k8sClient, pgdbName, pgdbNamespace)).ToNot(HaveOccurred())
 	Expect(deletePGDB(ctx, k8sClient, pgdbName2, pgdbNamespace)).ToNot(HaveOccurred())
+	Expect(deletePGDB(ctx, k8sClient, pgdbName2, pgbackupNamespace)).ToNot(HaveOccurred())
 
 	// Close all connections in operator pool
 	// For this, use utils methods and official pool methods
@@ -275,6 +424,7 @@ func cleanupFunction() {
 
 	Expect(dropReplicationSlot(pgpublicationPublicationName1))
 	Expect(dropReplicationSlot(pgpublicationCustomReplicationSlotName))
+	Expect(dropReplicationSlot(pgreplicationslotSlotName))
 	Expect(deleteSQLDBs(pgdbDBName)).ToNot(HaveOccurred())
 	Expect(deleteSQLDBs(pgdbDBName2)).ToNot(HaveOccurred())
 	Expect(deleteSQLRoles()).ToNot(HaveOccurred())
@@ -290,6 +440,22 @@ func cleanupFunction() {
 	Expect(err).ToNot(HaveOccurred())
 	err = deleteSecret(ctx, k8sClient, editedSecretName, pgurNamespace)
 	Expect(err).ToNot(HaveOccurred())
+	err = deleteSecret(ctx, k8sClient, pgpublicationCDCSecretName, pgpublicationNamespace)
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteSecret(ctx, k8sClient, pgmigrationSourceName, pgmigrationNamespace)
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteObject(ctx, k8sClient, pgmigrationSourceName, pgmigrationNamespace, &corev1.ConfigMap{})
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteSecret(ctx, k8sClient, pgbackupName+BackupCredentialsSecretSuffix, pgbackupNamespace)
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteObject(ctx, k8sClient, pgbackupName+BackupJobSuffix, pgbackupNamespace, &batchv1.Job{})
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteObject(ctx, k8sClient, pgbackupName+BackupCleanupJobSuffix, pgbackupNamespace, &batchv1.Job{})
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteSecret(ctx, k8sClient, pgdbName2+RestoreCredentialsSecretSuffix, pgbackupNamespace)
+	Expect(err).ToNot(HaveOccurred())
+	err = deleteObject(ctx, k8sClient, pgdbName2+RestoreJobSuffix, pgbackupNamespace, &batchv1.Job{})
+	Expect(err).ToNot(HaveOccurred())
 }
 
 func getSecret(ctx context.Context, cli client.Client, name, namespace string) (*corev1.Secret, error) {
@@ -590,6 +756,139 @@ func setupManagedPGUR(userPasswordRotationDuration string) *postgresqlv1alpha1.P
 	return setupSavePGURInternal(it)
 }
 
+func setupManagedPGURWithOldRolesTerminationGracePeriod(
+	userPasswordRotationDuration, oldRolesTerminationGracePeriod string,
+) *postgresqlv1alpha1.PostgresqlUserRole {
+	it := &postgresqlv1alpha1.PostgresqlUserRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgurName,
+			Namespace: pgurNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+			Mode:                           postgresqlv1alpha1.ManagedMode,
+			RolePrefix:                     pgurRolePrefix,
+			WorkGeneratedSecretName:        pgurWorkSecretName,
+			UserPasswordRotationDuration:   userPasswordRotationDuration,
+			OldRolesTerminationGracePeriod: oldRolesTerminationGracePeriod,
+			Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+				{
+					Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+					Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+					GeneratedSecretName: pgurDBSecretName,
+				},
+			},
+		},
+	}
+
+	return setupSavePGURInternal(it)
+}
+
+func setupManagedPGURWithPasswordPolicy(
+	passwordPolicy *postgresqlv1alpha1.PasswordPolicy,
+) *postgresqlv1alpha1.PostgresqlUserRole {
+	it := &postgresqlv1alpha1.PostgresqlUserRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgurName,
+			Namespace: pgurNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+			Mode:                    postgresqlv1alpha1.ManagedMode,
+			RolePrefix:              pgurRolePrefix,
+			WorkGeneratedSecretName: pgurWorkSecretName,
+			PasswordPolicy:          passwordPolicy,
+			Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+				{
+					Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+					Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+					GeneratedSecretName: pgurDBSecretName,
+				},
+			},
+		},
+	}
+
+	return setupSavePGURInternal(it)
+}
+
+func setupManagedPGURWithValidUntilMargin(
+	userPasswordRotationDuration, validUntilMargin string,
+) *postgresqlv1alpha1.PostgresqlUserRole {
+	it := &postgresqlv1alpha1.PostgresqlUserRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgurName,
+			Namespace: pgurNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+			Mode:                         postgresqlv1alpha1.ManagedMode,
+			RolePrefix:                   pgurRolePrefix,
+			WorkGeneratedSecretName:      pgurWorkSecretName,
+			UserPasswordRotationDuration: userPasswordRotationDuration,
+			Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+				{
+					Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+					Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+					GeneratedSecretName: pgurDBSecretName,
+				},
+			},
+			RoleAttributes: &postgresqlv1alpha1.PostgresqlUserRoleAttributes{
+				ValidUntilMargin: validUntilMargin,
+			},
+		},
+	}
+
+	return setupSavePGURInternal(it)
+}
+
+func setupManagedPGURWithRoleAttributes(
+	roleAttributes *postgresqlv1alpha1.PostgresqlUserRoleAttributes,
+) *postgresqlv1alpha1.PostgresqlUserRole {
+	it := &postgresqlv1alpha1.PostgresqlUserRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgurName,
+			Namespace: pgurNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlUserRoleSpec{
+			Mode:                    postgresqlv1alpha1.ManagedMode,
+			RolePrefix:              pgurRolePrefix,
+			WorkGeneratedSecretName: pgurWorkSecretName,
+			Privileges: []*postgresqlv1alpha1.PostgresqlUserRolePrivilege{
+				{
+					Privilege:           postgresqlv1alpha1.OwnerPrivilege,
+					Database:            &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+					GeneratedSecretName: pgurDBSecretName,
+				},
+			},
+			RoleAttributes: roleAttributes,
+		},
+	}
+
+	return setupSavePGURInternal(it)
+}
+
+func updatePGECAllowedUserRoleAttributes(
+	pgec *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	allowed []postgresqlv1alpha1.DangerousUserRoleAttribute,
+) {
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      pgec.Name,
+				Namespace: pgec.Namespace,
+			}, pgec)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			pgec.Spec.AllowedUserRoleAttributes = allowed
+
+			return k8sClient.Update(ctx, pgec)
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+}
+
 func setupManagedPGURWithPartialCustomAttributes() *postgresqlv1alpha1.PostgresqlUserRole {
 	it := &postgresqlv1alpha1.PostgresqlUserRole{
 		ObjectMeta: v1.ObjectMeta{
@@ -718,10 +1017,15 @@ func setupPGPublicationWithPartialSpec(partialSpec postgresqlv1alpha1.Postgresql
 			AllTables:             partialSpec.AllTables,
 			TablesInSchema:        partialSpec.TablesInSchema,
 			Tables:                partialSpec.Tables,
+			TableSelector:         partialSpec.TableSelector,
+			Owner:                 partialSpec.Owner,
 			WithParameters:        partialSpec.WithParameters,
 			DropOnDelete:          partialSpec.DropOnDelete,
 			ReplicationSlotName:   partialSpec.ReplicationSlotName,
 			ReplicationSlotPlugin: partialSpec.ReplicationSlotPlugin,
+			SlotMonitoring:        partialSpec.SlotMonitoring,
+			SlotSafeguard:         partialSpec.SlotSafeguard,
+			CDC:                   partialSpec.CDC,
 		},
 	}
 
@@ -759,6 +1063,301 @@ func setupSavePGPublicationInternal(it *postgresqlv1alpha1.PostgresqlPublication
 	return it
 }
 
+func setupPGRLSPolicyWithPartialSpec(
+	partialSpec postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec,
+) *postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy {
+	it := &postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgrlspolicyName,
+			Namespace: pgrlspolicyNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicySpec{
+			Database:              &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			Name:                  pgrlspolicyPolicyName1,
+			Table:                 "fake",
+			Command:               partialSpec.Command,
+			Restrictive:           partialSpec.Restrictive,
+			Roles:                 partialSpec.Roles,
+			DatabaseRoles:         partialSpec.DatabaseRoles,
+			Using:                 partialSpec.Using,
+			WithCheck:             partialSpec.WithCheck,
+			ForceRowLevelSecurity: partialSpec.ForceRowLevelSecurity,
+		},
+	}
+
+	return setupSavePGRLSPolicyInternal(it)
+}
+
+func setupSavePGRLSPolicyInternal(
+	it *postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy,
+) *postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy {
+	// Create policy
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated policy
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.RowLevelSecurityPolicyNoPhase {
+				return gerrors.New("pgrls hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
+func setupPGMigrationConfigMap(data map[string]string) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgmigrationSourceName,
+			Namespace: pgmigrationNamespace,
+		},
+		Data: data,
+	}
+
+	Expect(k8sClient.Create(ctx, cm)).To(Succeed())
+
+	return cm
+}
+
+func setupPGMigrationSecret(data map[string]string) *corev1.Secret {
+	sec := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgmigrationSourceName,
+			Namespace: pgmigrationNamespace,
+		},
+		StringData: data,
+	}
+
+	Expect(k8sClient.Create(ctx, sec)).To(Succeed())
+
+	return sec
+}
+
+func setupPGMigration(
+	configMapName, secretName string,
+) *postgresqlv1alpha1.PostgresqlMigration {
+	it := &postgresqlv1alpha1.PostgresqlMigration{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgmigrationName,
+			Namespace: pgmigrationNamespace,
+		},
+		Spec: postgresqlv1alpha1.PostgresqlMigrationSpec{
+			Database:      &common.CRLink{Name: pgdbName, Namespace: pgdbNamespace},
+			ConfigMapName: configMapName,
+			SecretName:    secretName,
+		},
+	}
+
+	// Create migration
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated migration
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.MigrationNoPhase {
+				return gerrors.New("pgmig hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
+func setupPGBackup(
+	spec postgresqlv1alpha1.PostgresqlBackupSpec,
+) *postgresqlv1alpha1.PostgresqlBackup {
+	it := &postgresqlv1alpha1.PostgresqlBackup{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgbackupName,
+			Namespace: pgbackupNamespace,
+		},
+		Spec: spec,
+	}
+
+	// Create backup
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated backup
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.BackupNoPhase {
+				return gerrors.New("pgbackup hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
+func setupPGBackupSchedule(
+	spec postgresqlv1alpha1.PostgresqlBackupScheduleSpec,
+) *postgresqlv1alpha1.PostgresqlBackupSchedule {
+	it := &postgresqlv1alpha1.PostgresqlBackupSchedule{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgbackupScheduleName,
+			Namespace: pgbackupNamespace,
+		},
+		Spec: spec,
+	}
+
+	// Create backup schedule
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated backup schedule
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.BackupScheduleNoPhase {
+				return gerrors.New("pgbackupschedule hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
+func setupPGSequenceSync(
+	spec postgresqlv1alpha1.PostgresqlSequenceSyncSpec,
+) *postgresqlv1alpha1.PostgresqlSequenceSync {
+	it := &postgresqlv1alpha1.PostgresqlSequenceSync{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgsequencesyncName,
+			Namespace: pgpublicationNamespace,
+		},
+		Spec: spec,
+	}
+
+	// Create sequence sync
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated sequence sync
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.SequenceSyncNoPhase {
+				return gerrors.New("pgsequencesync hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
+func setupPGReplicationSlot(
+	spec postgresqlv1alpha1.PostgresqlReplicationSlotSpec,
+) *postgresqlv1alpha1.PostgresqlReplicationSlot {
+	it := &postgresqlv1alpha1.PostgresqlReplicationSlot{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      pgreplicationslotName,
+			Namespace: pgreplicationslotNamespace,
+		},
+		Spec: spec,
+	}
+
+	// Create replication slot
+	Expect(k8sClient.Create(ctx, it)).Should(Succeed())
+
+	// Get updated replication slot
+	Eventually(
+		func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{
+				Name:      it.Name,
+				Namespace: it.Namespace,
+			}, it)
+			// Check error
+			if err != nil {
+				return err
+			}
+
+			// Check if status hasn't been updated
+			if it.Status.Phase == postgresqlv1alpha1.ReplicationSlotNoPhase {
+				return gerrors.New("pgreplicationslot hasn't been updated by operator")
+			}
+
+			return nil
+		},
+		generalEventuallyTimeout,
+		generalEventuallyInterval,
+	).
+		Should(Succeed())
+
+	return it
+}
+
 func setupPGEC(
 	checkInterval string,
 	waitLinkedResourcesDeletion bool,
@@ -966,26 +1565,85 @@ func setupSavePGDBInternal(
 	).
 		Should(Succeed())
 
-	return pgdb
+	return pgdb
+}
+
+func deletePGDB(ctx context.Context, cl client.Client, name, namespace string) error {
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlDatabase{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
+}
+
+func deletePGUR(ctx context.Context, cl client.Client, name, namespace string) error {
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlUserRole{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
+}
+
+func deletePGPublication(ctx context.Context, cl client.Client, name, namespace string) error {
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlPublication{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
+}
+
+func deletePGRLSPolicy(ctx context.Context, cl client.Client, name, namespace string) error {
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlRowLevelSecurityPolicy{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
+}
+
+func deletePGBackup(ctx context.Context, cl client.Client, name, namespace string) error {
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlBackup{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
+}
+
+func deletePGBackupSchedule(ctx context.Context, cl client.Client, name, namespace string) error {
+	// List backups created by schedule
+	list := &postgresqlv1alpha1.PostgresqlBackupList{}
+	err := cl.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{BackupScheduleLabelKey: name})
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Delete them
+	for _, it := range list.Items {
+		err = deletePGBackup(ctx, cl, it.Name, namespace)
+		// Check error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create structure
+	st := &postgresqlv1alpha1.PostgresqlBackupSchedule{}
+	// Delete
+	return deleteObject(ctx, cl, name, namespace, st)
 }
 
-func deletePGDB(ctx context.Context, cl client.Client, name, namespace string) error {
+func deletePGReplicationSlot(ctx context.Context, cl client.Client, name, namespace string) error {
 	// Create structure
-	st := &postgresqlv1alpha1.PostgresqlDatabase{}
+	st := &postgresqlv1alpha1.PostgresqlReplicationSlot{}
 	// Delete
 	return deleteObject(ctx, cl, name, namespace, st)
 }
 
-func deletePGUR(ctx context.Context, cl client.Client, name, namespace string) error {
+func deletePGSequenceSync(ctx context.Context, cl client.Client, name, namespace string) error {
 	// Create structure
-	st := &postgresqlv1alpha1.PostgresqlUserRole{}
+	st := &postgresqlv1alpha1.PostgresqlSequenceSync{}
 	// Delete
 	return deleteObject(ctx, cl, name, namespace, st)
 }
 
-func deletePGPublication(ctx context.Context, cl client.Client, name, namespace string) error {
+func deletePGMigration(ctx context.Context, cl client.Client, name, namespace string) error {
 	// Create structure
-	st := &postgresqlv1alpha1.PostgresqlPublication{}
+	st := &postgresqlv1alpha1.PostgresqlMigration{}
 	// Delete
 	return deleteObject(ctx, cl, name, namespace, st)
 }
@@ -1080,6 +1738,65 @@ func isSQLDBExists(name string) (bool, error) {
 	return nb == 1, nil
 }
 
+func isSQLDBTombstoneExists(name string) (bool, error) {
+	if mainDBConn == nil {
+		db, err := sql.Open("postgres", postgresUrl)
+		if err != nil {
+			return false, err
+		}
+		mainDBConn = db
+	}
+
+	res, err := mainDBConn.Exec(fmt.Sprintf(
+		`SELECT 1 FROM pg_database WHERE shobj_description(oid, 'pg_database') LIKE '{"tombstoneOf":"%s"%%'`,
+		name,
+	))
+	if err != nil {
+		return false, err
+	}
+	// Get affected rows
+	nb, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return nb == 1, nil
+}
+
+func createSQLDBTombstone(database string, deletedAt, dropAfter time.Time, roles ...string) (string, error) {
+	if mainDBConn == nil {
+		db, err := sql.Open("postgres", postgresUrl)
+		if err != nil {
+			return "", err
+		}
+		mainDBConn = db
+	}
+
+	name := postgres.BuildDatabaseTombstoneName(database, deletedAt)
+
+	_, err := mainDBConn.Exec(fmt.Sprintf(`CREATE DATABASE "%s"`, name))
+	if err != nil {
+		return "", err
+	}
+
+	meta, err := json.Marshal(&postgres.DatabaseTombstone{
+		Database:  database,
+		DeletedAt: deletedAt.UTC().Truncate(time.Second),
+		DropAfter: dropAfter.UTC().Truncate(time.Second),
+		Roles:     roles,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = mainDBConn.Exec(fmt.Sprintf(postgres.CommentOnDatabaseSQLTemplate, name, pq.QuoteLiteral(string(meta))))
+	if err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
 func deleteSQLRoles() error {
 	// Query template
 	GetAllCreatedRolesSQLTemplate := `SELECT rolname FROM pg_roles WHERE rolname NOT LIKE 'pg\_%' AND rolname != 'postgres'`
@@ -1267,6 +1984,70 @@ func rawSQLQuery(raw string) error {
 	return nil
 }
 
+func rawSQLQueryInDBName(dbName, raw string) error {
+	// Connect
+	db, err := sql.Open("postgres", fmt.Sprintf(postgresUrlWithDbTemplate, postgresUser, postgresPassword, dbName))
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	_, err = db.Exec(raw)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func rawSQLQueryInt64InDBName(dbName, raw string) (int64, error) {
+	// Connect
+	db, err := sql.Open("postgres", fmt.Sprintf(postgresUrlWithDbTemplate, postgresUser, postgresPassword, dbName))
+	// Check error
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	var res int64
+
+	err = db.QueryRow(raw).Scan(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func rawSQLQueryBoolInDB(raw string) (bool, error) {
+	// Connect
+	db, err := sql.Open("postgres", postgresUrlToDB)
+	// Check error
+	if err != nil {
+		return false, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	res := false
+
+	err = db.QueryRow(raw).Scan(&res)
+	if err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
+
 func createTableInSchemaAsAdmin(schema, table string) error {
 	// Query template
 	CreateTableInSchemaTemplate := `CREATE TABLE %s.%s()`
@@ -1410,6 +2191,85 @@ func createTypeInSchemaAsAdmin(schema, typeName string) error {
 	return nil
 }
 
+type PolicyResult struct {
+	Using      *string
+	WithCheck  *string
+	Command    string
+	Roles      []string
+	Permissive bool
+}
+
+func getPolicy(schema, table, name string) (*PolicyResult, error) {
+	// Connect
+	db, err := sql.Open("postgres", postgresUrlToDB)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	// Get rows
+	rows, err := db.Query(fmt.Sprintf(postgres.GetPolicySQLTemplate, schema, table, name))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res *PolicyResult
+
+	for rows.Next() {
+		var permissive string
+
+		var roles pq.StringArray
+
+		res = &PolicyResult{}
+		// Scan
+		err = rows.Scan(&permissive, &roles, &res.Command, &res.Using, &res.WithCheck)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		res.Permissive = permissive == postgres.PermissivePolicy
+		res.Roles = roles
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func getTableRowLevelSecurity(schema, table string) (bool, bool, error) {
+	// Connect
+	db, err := sql.Open("postgres", postgresUrlToDB)
+	// Check error
+	if err != nil {
+		return false, false, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	var enabled, forced bool
+
+	err = db.QueryRow(fmt.Sprintf(postgres.GetTableRowLevelSecuritySQLTemplate, schema, table)).Scan(&enabled, &forced)
+	if err != nil {
+		return false, false, err
+	}
+
+	return enabled, forced, nil
+}
+
 type PublicationResult struct {
 	Owner              string
 	AllTables          bool
@@ -1624,7 +2484,7 @@ func getReplicationSlot(name string) (*replicationSlotResult, error) {
 }
 
 func getReplicationSlotInternal(db *sql.DB, name string) (*replicationSlotResult, error) {
-	GetReplicationSlotSQLTemplate := `SELECT slot_name,plugin,database FROM pg_replication_slots WHERE slot_name = '%s'`
+	GetReplicationSlotSQLTemplate := `SELECT slot_name,COALESCE(plugin,''),COALESCE(database,'') FROM pg_replication_slots WHERE slot_name = '%s'`
 
 	// Get rows
 	rows, err := db.Query(fmt.Sprintf(GetReplicationSlotSQLTemplate, name))
@@ -1716,6 +2576,103 @@ func getRoleAttributes(role string) (*RoleAttributes, error) {
 	return res, nil
 }
 
+type RoleExtendedAttributes struct {
+	CreateDB   *bool
+	CreateRole *bool
+	Inherit    *bool
+	Login      *bool
+}
+
+func getRoleExtendedAttributes(role string) (*RoleExtendedAttributes, error) {
+	res := &RoleExtendedAttributes{
+		CreateDB:   new(bool),
+		CreateRole: new(bool),
+		Inherit:    new(bool),
+		Login:      new(bool),
+	}
+
+	// Connect
+	db, err := sql.Open("postgres", postgresUrl)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	rows, err := db.Query(fmt.Sprintf(`select rolcreatedb, rolcreaterole, rolinherit, rolcanlogin FROM pg_roles WHERE rolname = '%s'`, role))
+	if err != nil {
+		return res, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		// Scan
+		err = rows.Scan(res.CreateDB, res.CreateRole, res.Inherit, res.Login)
+		// Check error
+		if err != nil {
+			return res, err
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func getRoleValidUntil(role string) (*time.Time, error) {
+	// Connect
+	db, err := sql.Open("postgres", postgresUrl)
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() error {
+		return db.Close()
+	}()
+
+	rows, err := db.Query(fmt.Sprintf(`SELECT CASE WHEN isfinite(rolvaliduntil) THEN rolvaliduntil END FROM pg_roles WHERE rolname = '%s'`, role))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var res *time.Time
+
+	for rows.Next() {
+		validUntil := sql.NullTime{}
+		// Scan
+		err = rows.Scan(&validUntil)
+		// Check error
+		if err != nil {
+			return nil, err
+		}
+
+		if validUntil.Valid {
+			res = &validUntil.Time
+		}
+	}
+
+	// Rows error
+	err = rows.Err()
+	// Check error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func checkRoleInSQLDb(role string) {
 	roleExists, roleErr := isSQLRoleExists(role)
 	Expect(roleErr).ToNot(HaveOccurred())
@@ -1723,7 +2680,11 @@ func checkRoleInSQLDb(role string) {
 }
 
 func connectAs(username, password string) (string, error) {
-	u := fmt.Sprintf(postgresUrlWithDbTemplate, username, password, "postgres")
+	return connectToDBAs(username, password, "postgres")
+}
+
+func connectToDBAs(username, password, dbName string) (string, error) {
+	u := fmt.Sprintf(postgresUrlWithDbTemplate, username, password, dbName)
 	// Connect
 	db, err := sql.Open("postgres", u)
 	// Check error
@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Maximum time to search for next activation.
+const cronMaxSearchYears = 5
+
+type cronField struct {
+	min, max int
+}
+
+var (
+	cronMinutes = cronField{0, 59}
+	cronHours   = cronField{0, 23}
+	cronDom     = cronField{1, 31}
+	cronMonths  = cronField{1, 12}
+	// ? Note: 7 is allowed as sunday
+	cronDow = cronField{0, 7}
+)
+
+// Sunday can be 0 or 7 in day of week field.
+const cronSundayAlias = 7
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// CronSchedule is a parsed standard cron expression (minute hour day-of-month month day-of-week).
+// All times are computed in UTC.
+type CronSchedule struct {
+	minutes, hours, dom, months, dow uint64
+	// Standard cron behavior: when both day of month and day of week are restricted,
+	// a day is matching if one of them is matching.
+	domStar, dowStar bool
+}
+
+// ParseCronSchedule will parse a standard 5 fields cron expression or a descriptor (@daily, @hourly, ...).
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	// Check descriptors
+	if v, ok := cronDescriptors[spec]; ok {
+		spec = v
+	}
+
+	fields := strings.Fields(spec)
+	// Check length
+	if len(fields) != 5 { //nolint:gomnd // 5 fields in a cron expression
+		return nil, fmt.Errorf("cron expression %q must have 5 fields", spec)
+	}
+
+	res := &CronSchedule{
+		domStar: fields[2] == "*" || strings.HasPrefix(fields[2], "*/"),
+		dowStar: fields[4] == "*" || strings.HasPrefix(fields[4], "*/"),
+	}
+
+	var err error
+
+	res.minutes, err = parseCronField(fields[0], cronMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	res.hours, err = parseCronField(fields[1], cronHours)
+	if err != nil {
+		return nil, err
+	}
+
+	res.dom, err = parseCronField(fields[2], cronDom)
+	if err != nil {
+		return nil, err
+	}
+
+	res.months, err = parseCronField(fields[3], cronMonths)
+	if err != nil {
+		return nil, err
+	}
+
+	res.dow, err = parseCronField(fields[4], cronDow)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map 7 to sunday
+	if res.dow&(1<<cronSundayAlias) != 0 {
+		res.dow = res.dow&^(1<<cronSundayAlias) | 1
+	}
+
+	return res, nil
+}
+
+// Next will return the next activation time strictly after the given time.
+// Zero time is returned if nothing is found.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	// Start at next minute
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	// Limit
+	limit := t.AddDate(cronMaxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		// Check month
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+
+			continue
+		}
+
+		// Check day
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+
+			continue
+		}
+
+		// Check hour
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+
+			continue
+		}
+
+		// Check minute
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	// Both restricted case
+	if !s.domStar && !s.dowStar {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var res uint64
+
+	// Loop over list
+	for _, part := range strings.Split(field, ",") {
+		start, end, step := bounds.min, bounds.max, 1
+
+		rangePart := part
+		// Check step
+		if idx := strings.Index(part, "/"); idx != -1 {
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step in cron field %q", field)
+			}
+
+			step = s
+			rangePart = part[:idx]
+		}
+
+		// Check range
+		switch {
+		case rangePart == "*":
+			// Nothing to do, bounds are already set
+		case strings.Contains(rangePart, "-"):
+			spl := strings.SplitN(rangePart, "-", 2) //nolint:gomnd // Range has 2 parts
+
+			s, err := strconv.Atoi(spl[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range in cron field %q", field)
+			}
+
+			e, err := strconv.Atoi(spl[1])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range in cron field %q", field)
+			}
+
+			start, end = s, e
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in cron field %q", field)
+			}
+
+			start = v
+			// Single value with a step means from value to max
+			if !strings.Contains(part, "/") {
+				end = v
+			}
+		}
+
+		// Check bounds
+		if start < bounds.min || end > bounds.max || start > end {
+			return 0, fmt.Errorf("value out of range in cron field %q", field)
+		}
+
+		for i := start; i <= end; i += step {
+			res |= 1 << uint(i)
+		}
+	}
+
+	return res, nil
+}
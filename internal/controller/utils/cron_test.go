@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	base := time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every minute",
+			spec: "* * * * *",
+			from: base,
+			want: time.Date(2024, time.January, 31, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "daily descriptor",
+			spec: "@daily",
+			from: base,
+			want: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "hourly descriptor",
+			spec: "@hourly",
+			from: base,
+			want: time.Date(2024, time.January, 31, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "step on minutes",
+			spec: "*/15 * * * *",
+			from: base,
+			want: time.Date(2024, time.January, 31, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "list and range",
+			spec: "0 2,14 * * 1-5",
+			from: base,
+			want: time.Date(2024, time.January, 31, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month skipping short months",
+			spec: "0 3 30 * *",
+			from: base,
+			want: time.Date(2024, time.March, 30, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			spec: "0 0 * * 7",
+			from: base,
+			want: time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "range ending with sunday as 7",
+			spec: "0 0 * * 5-7",
+			from: time.Date(2024, time.February, 3, 10, 30, 0, 0, time.UTC),
+			want: time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			spec: "0 0 15 * 5",
+			from: base,
+			want: time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			spec: "0 0 29 2 *",
+			from: base,
+			want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseCronSchedule(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := s.Next(tt.from)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronScheduleDayOfWeek(t *testing.T) {
+	tests := []struct {
+		spec string
+		want uint64
+	}{
+		{spec: "* * * * *", want: 0b1111111},
+		{spec: "* * * * 0", want: 0b0000001},
+		{spec: "* * * * 7", want: 0b0000001},
+		{spec: "* * * * 0,7", want: 0b0000001},
+		{spec: "* * * * 1-7", want: 0b1111111},
+		{spec: "* * * * 5-7", want: 0b1100001},
+		{spec: "* * * * 1-5", want: 0b0111110},
+		{spec: "* * * * 6,7", want: 0b1000001},
+		{spec: "* * * * */2", want: 0b1010101},
+		{spec: "* * * * 1-7/3", want: 0b0010011},
+		{spec: "@weekly", want: 0b0000001},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseCronSchedule(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s.dow != tt.want {
+				t.Errorf("ParseCronSchedule(%q) day of week = %07b, want %07b", tt.spec, s.dow, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * * 7-1",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+
+	for _, spec := range tests {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseCronSchedule(spec)
+			if err == nil {
+				t.Errorf("ParseCronSchedule(%q) should fail", spec)
+			}
+		})
+	}
+}
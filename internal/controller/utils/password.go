@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+)
+
+// Special characters are limited to the ones that can be used without escaping in generated urls.
+var passwordCharacterClassesCharacters = map[postgresqlv1alpha1.PasswordCharacterClass]string{
+	postgresqlv1alpha1.LowercasePasswordCharacterClass: "abcdefghijklmnopqrstuvwxyz",
+	postgresqlv1alpha1.UppercasePasswordCharacterClass: "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	postgresqlv1alpha1.DigitsPasswordCharacterClass:    "1234567890",
+	postgresqlv1alpha1.SpecialPasswordCharacterClass:   "!$&*+,-.;=_~",
+}
+
+func getPasswordCharacterClassesCharacters(policy *postgresqlv1alpha1.PasswordPolicy) ([]string, error) {
+	// Prepare result
+	res := make([]string, 0)
+
+	// Loop over classes
+	for _, class := range policy.CharacterClasses {
+		// Get characters
+		chars, ok := passwordCharacterClassesCharacters[class]
+		// Check if class is supported
+		if !ok {
+			return nil, fmt.Errorf("password policy character class %s isn't supported", class)
+		}
+
+		// Remove excluded characters
+		chars = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(policy.ExcludedCharacters, r) {
+				return -1
+			}
+
+			return r
+		}, chars)
+
+		// Check that class isn't empty
+		if chars == "" {
+			return nil, fmt.Errorf("password policy character class %s have all characters excluded", class)
+		}
+
+		// Save
+		res = append(res, chars)
+	}
+
+	return res, nil
+}
+
+func ValidatePasswordPolicy(policy *postgresqlv1alpha1.PasswordPolicy) error {
+	// Check character classes
+	if len(policy.CharacterClasses) == 0 {
+		return errors.New("password policy must have at least one character class")
+	}
+
+	// Check that length is enough to have one character per class
+	if policy.Length < len(policy.CharacterClasses) {
+		return fmt.Errorf("password policy length must be at least %d to contain all character classes", len(policy.CharacterClasses))
+	}
+
+	// Check classes
+	_, err := getPasswordCharacterClassesCharacters(policy)
+
+	return err
+}
+
+func GeneratePasswordWithPolicy(policy *postgresqlv1alpha1.PasswordPolicy) (string, error) {
+	// Get classes characters
+	classes, err := getPasswordCharacterClassesCharacters(policy)
+	// Check error
+	if err != nil {
+		return "", err
+	}
+
+	// Build all allowed characters
+	allChars := strings.Join(classes, "")
+
+	b := make([]byte, policy.Length)
+	// Ensure at least one character per class
+	for i, chars := range classes {
+		b[i] = chars[seededRand.Intn(len(chars))]
+	}
+	// Complete with any allowed character
+	for i := len(classes); i < len(b); i++ {
+		b[i] = allChars[seededRand.Intn(len(allChars))]
+	}
+
+	// Shuffle to avoid having classes always at the beginning
+	seededRand.Shuffle(len(b), func(i, j int) { b[i], b[j] = b[j], b[i] })
+
+	return string(b), nil
+}
+
+func ValidatePasswordWithPolicy(password string, policy *postgresqlv1alpha1.PasswordPolicy) error {
+	// Check length
+	if len(password) < policy.Length {
+		return fmt.Errorf("password must have at least %d characters", policy.Length)
+	}
+
+	// Get classes characters
+	classes, err := getPasswordCharacterClassesCharacters(policy)
+	// Check error
+	if err != nil {
+		return err
+	}
+
+	// Check that each class is present
+	for i, chars := range classes {
+		if !strings.ContainsAny(password, chars) {
+			return fmt.Errorf("password must contain at least one character of class %s", policy.CharacterClasses[i])
+		}
+	}
+
+	// Check that password doesn't contain excluded or unknown characters
+	allChars := strings.Join(classes, "")
+	for _, r := range password {
+		if !strings.ContainsRune(allChars, r) {
+			return errors.New("password contains a character that isn't allowed by password policy")
+		}
+	}
+
+	return nil
+}
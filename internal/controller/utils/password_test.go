@@ -0,0 +1,228 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+)
+
+func TestValidatePasswordPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *postgresqlv1alpha1.PasswordPolicy
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length: 15,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.UppercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+					postgresqlv1alpha1.SpecialPasswordCharacterClass,
+				},
+				ExcludedCharacters: "0Ol1",
+			},
+		},
+		{
+			name:    "no character class",
+			policy:  &postgresqlv1alpha1.PasswordPolicy{Length: 15},
+			wantErr: "password policy must have at least one character class",
+		},
+		{
+			name: "length too small for classes",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length: 1,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+				},
+			},
+			wantErr: "password policy length must be at least 2 to contain all character classes",
+		},
+		{
+			name: "unsupported class",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length:           15,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{"EMOJI"},
+			},
+			wantErr: "password policy character class EMOJI isn't supported",
+		},
+		{
+			name: "class with all characters excluded",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length: 15,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+				},
+				ExcludedCharacters: "1234567890",
+			},
+			wantErr: "password policy character class DIGITS have all characters excluded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePasswordPolicy(tt.policy)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePasswordPolicy() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidatePasswordPolicy() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordWithPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *postgresqlv1alpha1.PasswordPolicy
+	}{
+		{
+			name: "single class",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length:           8,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{postgresqlv1alpha1.DigitsPasswordCharacterClass},
+			},
+		},
+		{
+			name: "length equals classes",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length: 4,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.UppercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+					postgresqlv1alpha1.SpecialPasswordCharacterClass,
+				},
+			},
+		},
+		{
+			name: "all classes with excluded characters",
+			policy: &postgresqlv1alpha1.PasswordPolicy{
+				Length: 100,
+				CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+					postgresqlv1alpha1.LowercasePasswordCharacterClass,
+					postgresqlv1alpha1.UppercasePasswordCharacterClass,
+					postgresqlv1alpha1.DigitsPasswordCharacterClass,
+					postgresqlv1alpha1.SpecialPasswordCharacterClass,
+				},
+				ExcludedCharacters: "0Ol1!$&",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Generate several times as generation is random
+			for i := 0; i < 50; i++ {
+				got, err := GeneratePasswordWithPolicy(tt.policy)
+				if err != nil {
+					t.Fatalf("GeneratePasswordWithPolicy() error = %v", err)
+				}
+
+				if len(got) != tt.policy.Length {
+					t.Fatalf("GeneratePasswordWithPolicy() length = %d, want %d", len(got), tt.policy.Length)
+				}
+
+				if strings.ContainsAny(got, tt.policy.ExcludedCharacters) {
+					t.Fatalf("GeneratePasswordWithPolicy() = %v, contains excluded characters", got)
+				}
+
+				// Generated password must be accepted by validation
+				err = ValidatePasswordWithPolicy(got, tt.policy)
+				if err != nil {
+					t.Fatalf("ValidatePasswordWithPolicy(%v) error = %v", got, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordWithPolicyErrors(t *testing.T) {
+	_, err := GeneratePasswordWithPolicy(&postgresqlv1alpha1.PasswordPolicy{
+		Length:           15,
+		CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{"EMOJI"},
+	})
+	if err == nil {
+		t.Errorf("GeneratePasswordWithPolicy() should fail with unsupported class")
+	}
+}
+
+func TestValidatePasswordWithPolicy(t *testing.T) {
+	policy := &postgresqlv1alpha1.PasswordPolicy{
+		Length: 10,
+		CharacterClasses: []postgresqlv1alpha1.PasswordCharacterClass{
+			postgresqlv1alpha1.LowercasePasswordCharacterClass,
+			postgresqlv1alpha1.UppercasePasswordCharacterClass,
+			postgresqlv1alpha1.DigitsPasswordCharacterClass,
+		},
+		ExcludedCharacters: "0O",
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  string
+	}{
+		{
+			name:     "valid password",
+			password: "abcDEF1234",
+		},
+		{
+			name:     "longer password",
+			password: "abcDEF1234abcDEF1234",
+		},
+		{
+			name:     "too short",
+			password: "abcDE123",
+			wantErr:  "password must have at least 10 characters",
+		},
+		{
+			name:     "missing uppercase",
+			password: "abcdef1234",
+			wantErr:  "password must contain at least one character of class UPPERCASE",
+		},
+		{
+			name:     "missing digits",
+			password: "abcdefGHIJ",
+			wantErr:  "password must contain at least one character of class DIGITS",
+		},
+		{
+			name:     "excluded character",
+			password: "abcDEF1230",
+			wantErr:  "password contains a character that isn't allowed by password policy",
+		},
+		{
+			name:     "character from another class",
+			password: "abcDEF123!",
+			wantErr:  "password contains a character that isn't allowed by password policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePasswordWithPolicy(tt.password, policy)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePasswordWithPolicy() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidatePasswordWithPolicy() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	postgresqlv1alpha1 "github.com/easymile/postgresql-operator/api/postgresql/v1alpha1"
+	"github.com/easymile/postgresql-operator/internal/controller/postgresql/postgres"
+	"github.com/go-logr/logr"
+)
+
+const (
+	memberLoginRoleRandomLength   = 10
+	memberLoginRolePasswordLength = 20
+)
+
+// CreateMemberLoginRole will create a login role with a random name and password and member of the given role.
+// Returned login must be used to connect as it can be different from role on some providers.
+func CreateMemberLoginRole(
+	ctx context.Context,
+	pg postgres.PG,
+	prefix, memberOf string,
+) (role, login, password string, err error) {
+	// Generate role name and password
+	role = prefix + strings.ToLower(GetRandomString(memberLoginRoleRandomLength))
+	password = GetRandomString(memberLoginRolePasswordLength)
+
+	// Create role
+	login, err = pg.CreateUserRole(ctx, role, password, nil)
+	// Check error
+	if err != nil {
+		return "", "", "", err
+	}
+
+	// Add it as member
+	err = pg.GrantRole(ctx, memberOf, role, false)
+	// Check error
+	if err != nil {
+		// Drop created role
+		// ? Note: Error is ignored here as grant error is the one to report
+		_ = pg.DropRole(ctx, role)
+
+		return "", "", "", err
+	}
+
+	return role, login, password, nil
+}
+
+// CreatePgInstanceForLogin will create a PG instance connected with another login than the engine configuration one.
+// Saved pools must be closed with CloseSavedPoolsForLogin when instance isn't used anymore.
+func CreatePgInstanceForLogin(
+	reqLogger logr.Logger,
+	pgec *postgresqlv1alpha1.PostgresqlEngineConfiguration,
+	login, password, database string,
+) postgres.PG {
+	spec := pgec.Spec
+
+	return postgres.NewPG(
+		createNameKeyForLoginSavedPools(pgec, login),
+		spec.Host,
+		login,
+		password,
+		spec.URIArgs,
+		database,
+		spec.Port,
+		// ? Note: Provider specific behaviors are only needed for administration requests
+		postgresqlv1alpha1.NoProvider,
+		reqLogger,
+	)
+}
+
+func CloseSavedPoolsForLogin(pgec *postgresqlv1alpha1.PostgresqlEngineConfiguration, login string) error {
+	return postgres.CloseAllSavedPoolsForName(createNameKeyForLoginSavedPools(pgec, login))
+}
+
+func createNameKeyForLoginSavedPools(pgec *postgresqlv1alpha1.PostgresqlEngineConfiguration, login string) string {
+	return CreateNameKeyForSavedPools(pgec.Name, pgec.Namespace) + "/" + login
+}
@@ -57,6 +57,13 @@ func GetSecret(ctx context.Context, cl client.Client, name, namespace string) (*
 	return secret, err
 }
 
+func GetConfigMap(ctx context.Context, cl client.Client, name, namespace string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, configMap)
+
+	return configMap, err
+}
+
 func FindSecretPgEngineCfg(
 	ctx context.Context,
 	cl client.Client,
@@ -104,17 +111,26 @@ func FindPgEngineCfg(
 	ctx context.Context,
 	cl client.Client,
 	instance *postgresqlv1alpha1.PostgresqlDatabase,
+) (*postgresqlv1alpha1.PostgresqlEngineConfiguration, error) {
+	return FindPgEngineCfgFromLink(ctx, cl, instance.Spec.EngineConfiguration, instance.Namespace)
+}
+
+func FindPgEngineCfgFromLink(
+	ctx context.Context,
+	cl client.Client,
+	link *common.CRLink,
+	instanceNamespace string,
 ) (*postgresqlv1alpha1.PostgresqlEngineConfiguration, error) {
 	// Try to get namespace from spec
-	namespace := instance.Spec.EngineConfiguration.Namespace
+	namespace := link.Namespace
 	if namespace == "" {
 		// Namespace not found, take it from instance namespace
-		namespace = instance.Namespace
+		namespace = instanceNamespace
 	}
 
 	pgEngineCfg := &postgresqlv1alpha1.PostgresqlEngineConfiguration{}
 	err := cl.Get(ctx, client.ObjectKey{
-		Name:      instance.Spec.EngineConfiguration.Name,
+		Name:      link.Name,
 		Namespace: namespace,
 	}, pgEngineCfg)
 